@@ -0,0 +1,261 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultTokenLifetime is the lifetime requested for new access tokens.
+const DefaultTokenLifetime = time.Hour
+
+// refreshMargin is how long before its expiry an access token is refreshed,
+// at most a quarter of the lifetime of the token.
+const refreshMargin = time.Minute
+
+// ErrNotAuthenticated is returned by Refresh when the client holds no
+// refresh token and no credentials to log in again.
+var ErrNotAuthenticated = errors.New("client is not authenticated")
+
+// Tokens are the credentials issued by /auth/login and /auth/refresh.
+type Tokens struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// AuthUser is the summary of the user returned by /auth/login.
+type AuthUser struct {
+	Id        int    `json:"id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Gender    string `json:"gender"`
+	Image     string `json:"image"`
+}
+
+type authState struct {
+	mu        sync.Mutex
+	username  string
+	password  string
+	lifetime  time.Duration
+	tokens    Tokens
+	expiresAt time.Time
+	margin    time.Duration
+}
+
+// WithCredentials makes the client log in with username and password before
+// its first request, and again whenever its tokens can no longer be
+// refreshed.
+func WithCredentials(username, password string) Option {
+	return func(cfg *clientConfig) {
+		cfg.username = username
+		cfg.password = password
+	}
+}
+
+// WithAccessToken sends token as bearer token with every request. Without
+// credentials or a refresh token it is used until the server rejects it.
+func WithAccessToken(token string) Option {
+	return func(cfg *clientConfig) {
+		cfg.tokens.AccessToken = token
+	}
+}
+
+// WithTokenLifetime replaces DefaultTokenLifetime. The server counts in
+// minutes, shorter lifetimes are rounded up to a minute.
+func WithTokenLifetime(lifetime time.Duration) Option {
+	return func(cfg *clientConfig) {
+		cfg.tokenLifetime = lifetime
+	}
+}
+
+// Login authenticates with username and password. The issued tokens are
+// attached to every following request and the credentials are kept to log in
+// again once the tokens expire.
+func (dc *DummyClient) Login(ctx context.Context, username, password string) (AuthUser, error) {
+	dc.auth.mu.Lock()
+	defer dc.auth.mu.Unlock()
+	user, err := dc.login(ctx, username, password)
+	if err != nil {
+		return AuthUser{}, err
+	}
+	dc.auth.username, dc.auth.password = username, password
+	return user, nil
+}
+
+// Refresh exchanges the refresh token for new tokens, logging in again with
+// the stored credentials if the client holds no refresh token.
+func (dc *DummyClient) Refresh(ctx context.Context) (Tokens, error) {
+	dc.auth.mu.Lock()
+	defer dc.auth.mu.Unlock()
+	if err := dc.renew(ctx); err != nil {
+		return Tokens{}, err
+	}
+	return dc.auth.tokens, nil
+}
+
+// Tokens returns the tokens currently attached to requests.
+func (dc *DummyClient) Tokens() Tokens {
+	dc.auth.mu.Lock()
+	defer dc.auth.mu.Unlock()
+	return dc.auth.tokens
+}
+
+// GetCurrentUser returns the user owning the access token.
+func (dc *DummyClient) GetCurrentUser(ctx context.Context) (User, error) {
+	var user User
+	req := dc.request(ctx).SetResult(&user)
+	if err := dc.execute(req, http.MethodGet, "/auth/me"); err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+// login must be called with dc.auth.mu held.
+func (dc *DummyClient) login(ctx context.Context, username, password string) (AuthUser, error) {
+	var res struct {
+		AuthUser
+		Tokens
+	}
+	req := dc.request(withoutAuth(ctx)).SetResult(&res).SetBody(map[string]any{
+		"username":      username,
+		"password":      password,
+		"expiresInMins": dc.auth.expiresInMins(),
+	})
+	if err := dc.execute(req, http.MethodPost, "/auth/login"); err != nil {
+		return AuthUser{}, err
+	}
+	dc.auth.setTokens(res.Tokens)
+	return res.AuthUser, nil
+}
+
+// renew refreshes the tokens, falling back to logging in again. It must be
+// called with dc.auth.mu held.
+func (dc *DummyClient) renew(ctx context.Context) error {
+	if dc.auth.tokens.RefreshToken != "" {
+		var tokens Tokens
+		req := dc.request(withoutAuth(ctx)).SetResult(&tokens).SetBody(map[string]any{
+			"refreshToken":  dc.auth.tokens.RefreshToken,
+			"expiresInMins": dc.auth.expiresInMins(),
+		})
+		err := dc.execute(req, http.MethodPost, "/auth/refresh")
+		if err == nil {
+			dc.auth.setTokens(tokens)
+			return nil
+		}
+		// Logging in again would only hide a change of the schema
+		if dc.auth.username == "" || errors.Is(err, ErrUnknownFields) {
+			return err
+		}
+	}
+	if dc.auth.username == "" {
+		return ErrNotAuthenticated
+	}
+	_, err := dc.login(ctx, dc.auth.username, dc.auth.password)
+	return err
+}
+
+// accessToken returns the token to attach to a request, logging in or
+// refreshing first when the client has none or it is about to expire.
+func (dc *DummyClient) accessToken(ctx context.Context) (string, error) {
+	dc.auth.mu.Lock()
+	defer dc.auth.mu.Unlock()
+	a := &dc.auth
+	switch {
+	case a.tokens.AccessToken == "" && a.username != "":
+		if _, err := dc.login(ctx, a.username, a.password); err != nil {
+			return "", err
+		}
+	case a.tokens.AccessToken != "" && a.canRenew() && !a.expiresAt.IsZero() && time.Until(a.expiresAt) < a.margin:
+		if err := dc.renew(ctx); err != nil {
+			return "", err
+		}
+	}
+	return a.tokens.AccessToken, nil
+}
+
+// reauthenticate renews the tokens after the server refused the access token
+// rejected, unless another request already renewed them.
+func (dc *DummyClient) reauthenticate(ctx context.Context, rejected string) error {
+	dc.auth.mu.Lock()
+	defer dc.auth.mu.Unlock()
+	if dc.auth.tokens.AccessToken != rejected {
+		return nil
+	}
+	return dc.renew(ctx)
+}
+
+// authenticate is a resty middleware attaching the access token.
+func (dc *DummyClient) authenticate(_ *resty.Client, req *resty.Request) error {
+	if skipAuth(req.Context()) {
+		return nil
+	}
+	token, err := dc.accessToken(req.Context())
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.SetAuthToken(token)
+	}
+	return nil
+}
+
+func (a *authState) canRenew() bool {
+	return a.tokens.RefreshToken != "" || a.username != ""
+}
+
+func (a *authState) setTokens(tokens Tokens) {
+	a.tokens = tokens
+	a.expiresAt = tokenExpiry(tokens.AccessToken)
+	// A token living less than a few minutes would otherwise be refreshed
+	// before every request
+	a.margin = 0
+	if !a.expiresAt.IsZero() {
+		a.margin = max(min(refreshMargin, time.Until(a.expiresAt)/4), 0)
+	}
+}
+
+// expiresInMins is the lifetime requested for new tokens.
+func (a *authState) expiresInMins() int {
+	return max(int(math.Ceil(a.lifetime.Minutes())), 1)
+}
+
+// tokenExpiry reads the exp claim of a JWT, the signature is left to the
+// server. It returns the zero time when the token carries no expiry.
+func tokenExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if json.Unmarshal(payload, &claims) != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+type skipAuthKey struct{}
+
+// withoutAuth marks the requests made with ctx as not needing a token.
+func withoutAuth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipAuthKey{}, true)
+}
+
+func skipAuth(ctx context.Context) bool {
+	return ctx.Value(skipAuthKey{}) != nil
+}
@@ -0,0 +1,247 @@
+package dummyjson
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// authServer issues tokens living lifetime for the user "emilys" with the
+// password "secret", and serves /products/1 to the holders of a valid
+// access token.
+type authServer struct {
+	lifetime time.Duration
+
+	mu            sync.Mutex
+	issued        int
+	accessTokens  map[string]bool
+	refreshTokens map[string]bool
+	// reject is the number of next product requests refused with 401
+	reject        int
+	logins        int
+	refreshes     int
+	requests      int
+	expiresInMins []int
+}
+
+// newJWT returns an unsigned token expiring at exp.
+func newJWT(exp time.Time, n int) string {
+	encode := func(v string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(v))
+	}
+	return encode(`{"alg":"none"}`) + "." + encode(fmt.Sprintf(`{"exp":%d,"n":%d}`, exp.Unix(), n)) + ".sig"
+}
+
+func (s *authServer) issue() Tokens {
+	if s.accessTokens == nil {
+		s.accessTokens, s.refreshTokens = map[string]bool{}, map[string]bool{}
+	}
+	s.issued++
+	tokens := Tokens{
+		AccessToken:  newJWT(time.Now().Add(s.lifetime), s.issued),
+		RefreshToken: fmt.Sprintf("refresh-%d", s.issued),
+	}
+	s.accessTokens[tokens.AccessToken] = true
+	s.refreshTokens[tokens.RefreshToken] = true
+	return tokens
+}
+
+func (s *authServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var body struct {
+		Username      string `json:"username"`
+		Password      string `json:"password"`
+		RefreshToken  string `json:"refreshToken"`
+		ExpiresInMins int    `json:"expiresInMins"`
+	}
+	if r.Method == http.MethodPost {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+	switch r.URL.Path {
+	case "/auth/login":
+		s.logins++
+		s.expiresInMins = append(s.expiresInMins, body.ExpiresInMins)
+		if body.Username != "emilys" || body.Password != "secret" {
+			writeJSON(w, http.StatusBadRequest, `{"message":"Invalid credentials"}`)
+			return
+		}
+		tokens := s.issue()
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"id":1,"username":"emilys","accessToken":%q,"refreshToken":%q}`, tokens.AccessToken, tokens.RefreshToken))
+	case "/auth/refresh":
+		s.refreshes++
+		s.expiresInMins = append(s.expiresInMins, body.ExpiresInMins)
+		if !s.refreshTokens[body.RefreshToken] {
+			writeJSON(w, http.StatusUnauthorized, `{"message":"Invalid refresh token"}`)
+			return
+		}
+		delete(s.refreshTokens, body.RefreshToken)
+		tokens := s.issue()
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"accessToken":%q,"refreshToken":%q}`, tokens.AccessToken, tokens.RefreshToken))
+	default:
+		s.requests++
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if s.reject > 0 {
+			s.reject--
+			delete(s.accessTokens, token)
+		}
+		if !s.accessTokens[token] {
+			writeJSON(w, http.StatusUnauthorized, `{"message":"Token Expired!"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"id":1}`)
+	}
+}
+
+// counts returns the number of logins, refreshes and product requests.
+func (s *authServer) counts() (logins, refreshes, requests int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins, s.refreshes, s.requests
+}
+
+func TestLogin(t *testing.T) {
+	server := &authServer{lifetime: time.Hour}
+	dc := newTestClient(t, server.ServeHTTP)
+	user, err := dc.Login(t.Context(), "emilys", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Username != "emilys" {
+		t.Errorf("got user %q, want emilys", user.Username)
+	}
+	if dc.Tokens().AccessToken == "" || dc.Tokens().RefreshToken == "" {
+		t.Errorf("got tokens %+v, want both tokens", dc.Tokens())
+	}
+	if _, err := dc.GetProduct(t.Context(), 1); err != nil {
+		t.Errorf("got error %v with the issued token", err)
+	}
+	if _, err := dc.Login(t.Context(), "emilys", "wrong"); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("got error %v with a wrong password, want %v", err, ErrBadRequest)
+	}
+}
+
+func TestCredentialsLogInOnce(t *testing.T) {
+	server := &authServer{lifetime: time.Hour}
+	dc := newTestClient(t, server.ServeHTTP, WithCredentials("emilys", "secret"))
+	for range 3 {
+		if _, err := dc.GetProduct(t.Context(), 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if logins, refreshes, _ := server.counts(); logins != 1 || refreshes != 0 {
+		t.Errorf("got %d logins and %d refreshes, want a single login", logins, refreshes)
+	}
+}
+
+func TestTokenLifetime(t *testing.T) {
+	tests := map[string]struct {
+		opts []Option
+		want int
+	}{
+		"default":              {want: 60},
+		"under a minute":       {opts: []Option{WithTokenLifetime(30 * time.Second)}, want: 1},
+		"rounded up":           {opts: []Option{WithTokenLifetime(90 * time.Second)}, want: 2},
+		"whole minutes":        {opts: []Option{WithTokenLifetime(5 * time.Minute)}, want: 5},
+		"zero stays a minimum": {opts: []Option{WithTokenLifetime(0)}, want: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := &authServer{lifetime: time.Hour}
+			dc := newTestClient(t, server.ServeHTTP, tt.opts...)
+			if _, err := dc.Login(t.Context(), "emilys", "secret"); err != nil {
+				t.Fatal(err)
+			}
+			if got := server.expiresInMins; len(got) != 1 || got[0] != tt.want {
+				t.Errorf("got expiresInMins %v, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProactiveRefresh(t *testing.T) {
+	tests := map[string]struct {
+		lifetime      time.Duration
+		remaining     time.Duration
+		wantRefreshes int
+	}{
+		"long lived token far from expiry":  {lifetime: time.Hour, remaining: 30 * time.Minute, wantRefreshes: 0},
+		"long lived token close to expiry":  {lifetime: time.Hour, remaining: 30 * time.Second, wantRefreshes: 1},
+		"short lived token far from expiry": {lifetime: 40 * time.Second, remaining: 30 * time.Second, wantRefreshes: 0},
+		"short lived token close to expiry": {lifetime: 40 * time.Second, remaining: 5 * time.Second, wantRefreshes: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := &authServer{lifetime: tt.lifetime}
+			dc := newTestClient(t, server.ServeHTTP, WithCredentials("emilys", "secret"))
+			if _, err := dc.GetProduct(t.Context(), 1); err != nil {
+				t.Fatal(err)
+			}
+			// Let the token age without waiting for it
+			dc.auth.mu.Lock()
+			dc.auth.expiresAt = time.Now().Add(tt.remaining)
+			dc.auth.mu.Unlock()
+			before := dc.Tokens().AccessToken
+			for range 3 {
+				if _, err := dc.GetProduct(t.Context(), 1); err != nil {
+					t.Fatal(err)
+				}
+			}
+			logins, refreshes, _ := server.counts()
+			if logins != 1 || refreshes != tt.wantRefreshes {
+				t.Errorf("got %d logins and %d refreshes, want 1 and %d", logins, refreshes, tt.wantRefreshes)
+			}
+			if renewed := dc.Tokens().AccessToken != before; renewed != (tt.wantRefreshes > 0) {
+				t.Errorf("access token renewed: %t, want %t", renewed, tt.wantRefreshes > 0)
+			}
+		})
+	}
+}
+
+func TestUnauthorizedRetriedOnce(t *testing.T) {
+	tests := map[string]struct {
+		opts          []Option
+		reject        int
+		wantErr       error
+		wantRefreshes int
+		wantRequests  int
+	}{
+		"renewed and retried": {
+			opts:          []Option{WithCredentials("emilys", "secret")},
+			reject:        1,
+			wantRefreshes: 1,
+			wantRequests:  2,
+		},
+		"retried only once": {
+			opts:          []Option{WithCredentials("emilys", "secret")},
+			reject:        2,
+			wantErr:       ErrUnauthorized,
+			wantRefreshes: 1,
+			wantRequests:  2,
+		},
+		"nothing to renew with": {
+			opts:         []Option{WithAccessToken(newJWT(time.Now().Add(time.Hour), 0))},
+			wantErr:      ErrUnauthorized,
+			wantRequests: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := &authServer{lifetime: time.Hour, reject: tt.reject}
+			dc := newTestClient(t, server.ServeHTTP, tt.opts...)
+			_, err := dc.GetProduct(t.Context(), 1)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if _, refreshes, requests := server.counts(); refreshes != tt.wantRefreshes || requests != tt.wantRequests {
+				t.Errorf("got %d refreshes and %d requests, want %d and %d", refreshes, requests, tt.wantRefreshes, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package dummyjson
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AvailabilityStatus tells whether a product can be ordered.
+type AvailabilityStatus string
+
+const (
+	InStock    AvailabilityStatus = "In Stock"
+	LowStock   AvailabilityStatus = "Low Stock"
+	OutOfStock AvailabilityStatus = "Out of Stock"
+)
+
+// AvailabilityStatuses are the statuses known to DummyJSON.
+var AvailabilityStatuses = []AvailabilityStatus{InStock, LowStock, OutOfStock}
+
+// IsValid reports whether the status is one of AvailabilityStatuses.
+func (as AvailabilityStatus) IsValid() bool {
+	return slices.Contains(AvailabilityStatuses, as)
+}
+
+func (as AvailabilityStatus) MarshalText() ([]byte, error) {
+	return []byte(as), nil
+}
+
+// UnmarshalText accepts the known statuses regardless of their case, other
+// statuses are kept as sent so that IsValid can report them.
+func (as *AvailabilityStatus) UnmarshalText(text []byte) error {
+	status := AvailabilityStatus(strings.TrimSpace(string(text)))
+	for _, known := range AvailabilityStatuses {
+		if strings.EqualFold(string(status), string(known)) {
+			status = known
+			break
+		}
+	}
+	*as = status
+	return nil
+}
+
+// StockThresholds derive the availability status of a product from its
+// stock.
+type StockThresholds struct {
+	// OutOfStock is the stock at or below which a product is out of stock
+	OutOfStock uint
+	// LowStock is the stock below which a product is low on stock
+	LowStock uint
+}
+
+// DefaultStockThresholds puts products out of stock at 0 and low on stock
+// below 10.
+var DefaultStockThresholds = StockThresholds{OutOfStock: 0, LowStock: 10}
+
+// Status returns the availability status of a product holding stock.
+func (st StockThresholds) Status(stock uint) AvailabilityStatus {
+	switch {
+	case stock <= st.OutOfStock:
+		return OutOfStock
+	case stock < st.LowStock:
+		return LowStock
+	}
+	return InStock
+}
+
+// CheckAvailability reports, as a ValidationError, a product whose declared
+// availability status contradicts the status derived from its stock. A
+// product without status is consistent. Clients created with
+// WithAvailabilityCheck run it before sending products.
+func (p Product) CheckAvailability(thresholds StockThresholds) error {
+	var v validator
+	if expected := thresholds.Status(p.Stock); p.AvailabilityStatus != "" && p.AvailabilityStatus != expected {
+		v.add("availabilityStatus", fmt.Sprintf("is %q but a stock of %d means %q", p.AvailabilityStatus, p.Stock, expected))
+	}
+	return v.err()
+}
+
+// CheckAvailability checks the patch like Product.CheckAvailability when it
+// sets both the stock and the availability status, the server keeping the
+// other one otherwise.
+func (pp ProductPatch) CheckAvailability(thresholds StockThresholds) error {
+	if pp.Stock == nil || pp.AvailabilityStatus == nil {
+		return nil
+	}
+	return Product{Stock: *pp.Stock, AvailabilityStatus: *pp.AvailabilityStatus}.CheckAvailability(thresholds)
+}
@@ -0,0 +1,137 @@
+package dummyjson
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestStockThresholdsStatus(t *testing.T) {
+	tests := map[string]struct {
+		thresholds StockThresholds
+		stock      uint
+		want       AvailabilityStatus
+	}{
+		"none left":            {thresholds: DefaultStockThresholds, stock: 0, want: OutOfStock},
+		"one left":             {thresholds: DefaultStockThresholds, stock: 1, want: LowStock},
+		"just below low":       {thresholds: DefaultStockThresholds, stock: 9, want: LowStock},
+		"at low":               {thresholds: DefaultStockThresholds, stock: 10, want: InStock},
+		"plenty":               {thresholds: DefaultStockThresholds, stock: 1000, want: InStock},
+		"at out of stock":      {thresholds: StockThresholds{OutOfStock: 5, LowStock: 20}, stock: 5, want: OutOfStock},
+		"above out of stock":   {thresholds: StockThresholds{OutOfStock: 5, LowStock: 20}, stock: 6, want: LowStock},
+		"no low stock":         {thresholds: StockThresholds{OutOfStock: 5, LowStock: 5}, stock: 6, want: InStock},
+		"zero thresholds":      {thresholds: StockThresholds{}, stock: 1, want: InStock},
+		"zero thresholds, out": {thresholds: StockThresholds{}, stock: 0, want: OutOfStock},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.thresholds.Status(tt.stock); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAvailabilityStatusUnmarshalText(t *testing.T) {
+	tests := map[string]struct {
+		text      string
+		want      AvailabilityStatus
+		wantValid bool
+	}{
+		"canonical":   {text: "In Stock", want: InStock, wantValid: true},
+		"lower case":  {text: "low stock", want: LowStock, wantValid: true},
+		"upper case":  {text: "OUT OF STOCK", want: OutOfStock, wantValid: true},
+		"spaces":      {text: "  in stock ", want: InStock, wantValid: true},
+		"unknown":     {text: "Sold out", want: "Sold out"},
+		"empty":       {text: "", want: ""},
+		"no space in": {text: "InStock", want: "InStock"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var status AvailabilityStatus
+			if err := status.UnmarshalText([]byte(tt.text)); err != nil {
+				t.Fatal(err)
+			}
+			if status != tt.want {
+				t.Errorf("got %q, want %q", status, tt.want)
+			}
+			if status.IsValid() != tt.wantValid {
+				t.Errorf("got valid: %t, want %t", status.IsValid(), tt.wantValid)
+			}
+			// The models decode the status through UnmarshalText too
+			var product Product
+			document, _ := json.Marshal(map[string]string{"availabilityStatus": tt.text})
+			if err := json.Unmarshal(document, &product); err != nil {
+				t.Fatal(err)
+			}
+			if product.AvailabilityStatus != tt.want {
+				t.Errorf("got %q from JSON, want %q", product.AvailabilityStatus, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckAvailability(t *testing.T) {
+	tests := map[string]struct {
+		product Product
+		patch   ProductPatch
+		wantErr bool
+	}{
+		"consistent":     {product: Product{Stock: 3, AvailabilityStatus: LowStock}, patch: ProductPatch{Stock: Ptr(uint(3)), AvailabilityStatus: Ptr(LowStock)}},
+		"no status":      {product: Product{Stock: 0}, patch: ProductPatch{Stock: Ptr(uint(0))}},
+		"only status":    {product: Product{Stock: 20, AvailabilityStatus: InStock}, patch: ProductPatch{AvailabilityStatus: Ptr(OutOfStock)}},
+		"contradiction":  {product: Product{Stock: 0, AvailabilityStatus: InStock}, patch: ProductPatch{Stock: Ptr(uint(0)), AvailabilityStatus: Ptr(InStock)}, wantErr: true},
+		"low stock left": {product: Product{Stock: 50, AvailabilityStatus: LowStock}, patch: ProductPatch{Stock: Ptr(uint(50)), AvailabilityStatus: Ptr(LowStock)}, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for kind, err := range map[string]error{
+				"product": tt.product.CheckAvailability(DefaultStockThresholds),
+				"patch":   tt.patch.CheckAvailability(DefaultStockThresholds),
+			} {
+				if (err != nil) != tt.wantErr {
+					t.Errorf("%s: got error %v, want error: %t", kind, err, tt.wantErr)
+				}
+				if err != nil && (!errors.Is(err, ErrInvalid) || paths(err)[0] != "availabilityStatus") {
+					t.Errorf("%s: got error %v, want an invalid availabilityStatus", kind, err)
+				}
+			}
+		})
+	}
+}
+
+func TestWithAvailabilityCheck(t *testing.T) {
+	inconsistent := Product{Title: "Mascara", Stock: 0, AvailabilityStatus: InStock}
+	tests := map[string]struct {
+		opts      []Option
+		wantErr   error
+		wantCalls int
+	}{
+		"not checked by default": {wantCalls: 2},
+		"checked":                {opts: []Option{WithAvailabilityCheck(DefaultStockThresholds)}, wantErr: ErrInvalid},
+		"without validation": {
+			opts:      []Option{WithAvailabilityCheck(DefaultStockThresholds), WithoutValidation()},
+			wantCalls: 2,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				writeJSON(w, http.StatusOK, `{"id":1}`)
+			}, tt.opts...)
+			_, errUpload := dc.UploadProduct(t.Context(), inconsistent)
+			_, errUpdate := dc.UpdateProduct(t.Context(), 1, ProductPatch{Stock: Ptr(uint(0)), AvailabilityStatus: Ptr(InStock)})
+			for _, err := range []error{errUpload, errUpdate} {
+				if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+type CartResponse struct {
+	Carts []Cart `json:"carts"`
+	Pagination
+}
+
+func (r CartResponse) items() []Cart          { return r.Carts }
+func (r CartResponse) pagination() Pagination { return r.Pagination }
+
+type Cart struct {
+	Id              int           `json:"id"`
+	Products        []CartProduct `json:"products"`
+	Total           float64       `json:"total"`
+	DiscountedTotal float64       `json:"discountedTotal"`
+	UserId          int           `json:"userId"`
+	TotalProducts   uint          `json:"totalProducts"`
+	TotalQuantity   uint          `json:"totalQuantity"`
+	IsDeleted       bool          `json:"isDeleted,omitempty"`
+	DeletedOn       Timestamp     `json:"deletedOn,omitzero"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Cart) UnmarshalJSON(data []byte) error {
+	type plain Cart
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c Cart) MarshalJSON() ([]byte, error) {
+	type plain Cart
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// CartProduct is a line of a cart. Its Id is the id of the Product in the
+// line, see GetCartLineProduct.
+type CartProduct struct {
+	Id                 int     `json:"id"`
+	Title              string  `json:"title"`
+	Price              float64 `json:"price"`
+	Quantity           uint    `json:"quantity"`
+	Total              float64 `json:"total"`
+	DiscountPercentage float64 `json:"discountPercentage"`
+	DiscountedTotal    float64 `json:"discountedTotal"`
+	Thumbnail          string  `json:"thumbnail"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (cp *CartProduct) UnmarshalJSON(data []byte) error {
+	type plain CartProduct
+	return unmarshalWithExtra(data, (*plain)(cp), &cp.Extra)
+}
+
+func (cp CartProduct) MarshalJSON() ([]byte, error) {
+	type plain CartProduct
+	return marshalWithExtra(plain(cp), cp.Extra)
+}
+
+// CartItem is a quantity of the product Id to put in a cart.
+type CartItem struct {
+	Id       int  `json:"id"`
+	Quantity uint `json:"quantity"`
+}
+
+// GetCarts walks every page of the cart listing, like GetProducts.
+func (dc *DummyClient) GetCarts(ctx context.Context, opts *ListOptions) ([]Cart, error) {
+	return listAll[Cart, CartResponse](ctx, dc, "/carts", nil, opts)
+}
+
+// CartsSeq lazily walks the cart listing, like ProductsSeq.
+func (dc *DummyClient) CartsSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Cart, error] {
+	return listSeq[Cart, CartResponse](ctx, dc, "/carts", nil, opts)
+}
+
+// GetCartsByUser returns the page of carts of the user userId selected by
+// opts.
+func (dc *DummyClient) GetCartsByUser(ctx context.Context, userId int, opts *ListOptions) (CartResponse, error) {
+	return getPage[Cart, CartResponse](ctx, dc, fmt.Sprintf("/carts/user/%d", userId), nil, opts, opts.skip())
+}
+
+func (dc *DummyClient) GetCart(ctx context.Context, id int) (Cart, error) {
+	var cart Cart
+	req := dc.request(ctx).SetResult(&cart)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/carts/%d", id)); err != nil {
+		return Cart{}, err
+	}
+	return cart, nil
+}
+
+// GetCartLineProduct returns the full product of a cart line.
+func (dc *DummyClient) GetCartLineProduct(ctx context.Context, line CartProduct) (Product, error) {
+	return dc.GetProduct(ctx, line.Id)
+}
+
+// AddCart creates a cart holding items for the user userId.
+func (dc *DummyClient) AddCart(ctx context.Context, userId int, items []CartItem) (Cart, error) {
+	var created Cart
+	req := dc.request(ctx).SetResult(&created).SetBody(map[string]any{
+		"userId":   userId,
+		"products": items,
+	})
+	if err := dc.execute(req, http.MethodPost, "/carts/add"); err != nil {
+		return Cart{}, err
+	}
+	return created, nil
+}
+
+// UpdateCart replaces the lines of the cart with items, or adds items to
+// its existing lines when merge is set.
+func (dc *DummyClient) UpdateCart(ctx context.Context, id int, items []CartItem, merge bool) (Cart, error) {
+	var updated Cart
+	req := dc.request(ctx).SetResult(&updated).SetBody(map[string]any{
+		"merge":    merge,
+		"products": items,
+	})
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/carts/%d", id)); err != nil {
+		return Cart{}, err
+	}
+	return updated, nil
+}
+
+func (dc *DummyClient) DeleteCart(ctx context.Context, id int) (Cart, error) {
+	var deleted Cart
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/carts/%d", id)); err != nil {
+		return Cart{}, err
+	}
+	return deleted, nil
+}
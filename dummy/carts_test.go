@@ -0,0 +1,110 @@
+package dummyjson
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+// cartRequest is a request received by a cart server.
+type cartRequest struct {
+	method, path string
+	body         string
+}
+
+// cartServer records the request it receives and answers with cart.
+func cartServer(received *cartRequest, cart string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*received = cartRequest{method: r.Method, path: r.URL.Path, body: string(body)}
+		writeJSON(w, http.StatusOK, cart)
+	}
+}
+
+// assertJSONBody fails unless body holds the same JSON document as want.
+func assertJSONBody(t *testing.T, body, want string) {
+	t.Helper()
+	var got, expected any
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatalf("got body %s: %v", body, err)
+	}
+	json.Unmarshal([]byte(want), &expected)
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(expected)
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("got body %s, want %s", gotJSON, wantJSON)
+	}
+}
+
+const cartDocument = `{"id":51,"products":[{"id":144,"title":"Cricket Helmet","price":44.99,"quantity":4,"total":179.96}],` +
+	`"total":179.96,"discountedTotal":179.96,"userId":33,"totalProducts":1,"totalQuantity":4}`
+
+func TestAddCart(t *testing.T) {
+	var received cartRequest
+	dc := newTestClient(t, cartServer(&received, cartDocument))
+	cart, err := dc.AddCart(t.Context(), 33, []CartItem{{Id: 144, Quantity: 4}, {Id: 98, Quantity: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.method != http.MethodPost || received.path != "/carts/add" {
+		t.Errorf("got request %s %s, want POST /carts/add", received.method, received.path)
+	}
+	assertJSONBody(t, received.body, `{"userId":33,"products":[{"id":144,"quantity":4},{"id":98,"quantity":1}]}`)
+	if cart.Id != 51 || cart.UserId != 33 || len(cart.Products) != 1 || cart.Products[0].Quantity != 4 {
+		t.Errorf("got cart %+v", cart)
+	}
+}
+
+func TestUpdateCart(t *testing.T) {
+	tests := map[string]struct {
+		merge bool
+		want  string
+	}{
+		"replace lines": {merge: false, want: `{"merge":false,"products":[{"id":144,"quantity":2}]}`},
+		"merge lines":   {merge: true, want: `{"merge":true,"products":[{"id":144,"quantity":2}]}`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var received cartRequest
+			dc := newTestClient(t, cartServer(&received, cartDocument))
+			if _, err := dc.UpdateCart(t.Context(), 51, []CartItem{{Id: 144, Quantity: 2}}, tt.merge); err != nil {
+				t.Fatal(err)
+			}
+			if received.method != http.MethodPatch || received.path != "/carts/51" {
+				t.Errorf("got request %s %s, want PATCH /carts/51", received.method, received.path)
+			}
+			// merge is sent even when false, the server would merge otherwise
+			assertJSONBody(t, received.body, tt.want)
+		})
+	}
+}
+
+func TestGetCartLineProduct(t *testing.T) {
+	var paths []string
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/carts/51":
+			writeJSON(w, http.StatusOK, cartDocument)
+		case "/products/144":
+			writeJSON(w, http.StatusOK, `{"id":144,"title":"Cricket Helmet","brand":"Circuit Cooler"}`)
+		default:
+			writeJSON(w, http.StatusNotFound, `{"message":"not found"}`)
+		}
+	})
+	cart, err := dc.GetCart(t.Context(), 51)
+	if err != nil {
+		t.Fatal(err)
+	}
+	product, err := dc.GetCartLineProduct(t.Context(), cart.Products[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if product.Id != 144 || product.Brand != "Circuit Cooler" {
+		t.Errorf("got product %+v, want the full product 144", product)
+	}
+	if len(paths) != 2 || paths[1] != "/products/144" {
+		t.Errorf("got requests to %v, want the cart then /products/144", paths)
+	}
+}
@@ -0,0 +1,83 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// Category is a product category as listed by /products/categories.
+type Category struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+	Url  string `json:"url"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Category) UnmarshalJSON(data []byte) error {
+	type plain Category
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c Category) MarshalJSON() ([]byte, error) {
+	type plain Category
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// Categories is the set of categories known to the server.
+type Categories []Category
+
+// Lookup returns the category identified by slug.
+func (c Categories) Lookup(slug string) (Category, bool) {
+	for _, category := range c {
+		if category.Slug == slug {
+			return category, true
+		}
+	}
+	return Category{}, false
+}
+
+// Contains reports whether slug identifies a known category, such as the
+// Category of a Product.
+func (c Categories) Contains(slug string) bool {
+	_, ok := c.Lookup(slug)
+	return ok
+}
+
+func (dc *DummyClient) GetCategories(ctx context.Context) (Categories, error) {
+	var categories Categories
+	req := dc.request(ctx).SetResult(&categories)
+	if err := dc.execute(req, http.MethodGet, "/products/categories"); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetCategorySlugs returns the slugs of every category.
+func (dc *DummyClient) GetCategorySlugs(ctx context.Context) ([]string, error) {
+	var slugs []string
+	req := dc.request(ctx).SetResult(&slugs)
+	if err := dc.execute(req, http.MethodGet, "/products/category-list"); err != nil {
+		return nil, err
+	}
+	return slugs, nil
+}
+
+// GetProductsByCategory returns the page of products in the category slug
+// selected by opts.
+func (dc *DummyClient) GetProductsByCategory(ctx context.Context, slug string, opts *ListOptions) (ProductResponse, error) {
+	return getPage[Product, ProductResponse](ctx, dc, categoryPath(slug), nil, opts, opts.skip())
+}
+
+// ProductsByCategorySeq lazily walks every product in the category slug,
+// like ProductsSeq.
+func (dc *DummyClient) ProductsByCategorySeq(ctx context.Context, slug string, opts *ListOptions) iter.Seq2[Product, error] {
+	return listSeq[Product, ProductResponse](ctx, dc, categoryPath(slug), nil, opts)
+}
+
+func categoryPath(slug string) string {
+	return "/products/category/" + url.PathEscape(slug)
+}
@@ -0,0 +1,113 @@
+package dummyjson
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+)
+
+const categoriesDocument = `[
+	{"slug":"beauty","name":"Beauty","url":"https://dummyjson.com/products/category/beauty"},
+	{"slug":"home-decoration","name":"Home Decoration","url":"https://dummyjson.com/products/category/home-decoration"}
+]`
+
+func TestGetCategories(t *testing.T) {
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/products/categories" {
+			t.Errorf("got request to %s, want /products/categories", r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, categoriesDocument)
+	})
+	categories, err := dc.GetCategories(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(categories) != 2 || categories[1].Name != "Home Decoration" || categories[1].Url == "" {
+		t.Errorf("got categories %+v", categories)
+	}
+}
+
+func TestCategoriesContains(t *testing.T) {
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, categoriesDocument)
+	})
+	categories, err := dc.GetCategories(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		slug string
+		want bool
+	}{
+		"known":          {slug: "home-decoration", want: true},
+		"name, not slug": {slug: "Home Decoration"},
+		"other case":     {slug: "Beauty"},
+		"unknown":        {slug: "groceries"},
+		"empty":          {slug: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := categories.Contains(tt.slug); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+			category, ok := categories.Lookup(tt.slug)
+			if ok != tt.want || (ok && category.Slug != tt.slug) {
+				t.Errorf("got %+v, %t from Lookup", category, ok)
+			}
+		})
+	}
+	if Categories(nil).Contains("beauty") {
+		t.Error("no categories contain beauty")
+	}
+}
+
+func TestGetCategorySlugs(t *testing.T) {
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/products/category-list" {
+			t.Errorf("got request to %s, want /products/category-list", r.URL.Path)
+		}
+		writeJSON(w, http.StatusOK, `["beauty","fragrances","home-decoration"]`)
+	})
+	slugs, err := dc.GetCategorySlugs(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"beauty", "fragrances", "home-decoration"}; !slices.Equal(slugs, want) {
+		t.Errorf("got slugs %v, want %v", slugs, want)
+	}
+}
+
+func TestGetProductsByCategory(t *testing.T) {
+	tests := map[string]struct {
+		slug     string
+		wantPath string
+	}{
+		"plain slug":       {slug: "beauty", wantPath: "/products/category/beauty"},
+		"escaped slug":     {slug: "home decoration", wantPath: "/products/category/home%20decoration"},
+		"slash in a slug":  {slug: "a/b", wantPath: "/products/category/a%2Fb"},
+		"question in slug": {slug: "what?", wantPath: "/products/category/what%3F"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var escapedPath, limit, skip string
+			dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				escapedPath = r.URL.EscapedPath()
+				limit, skip = r.URL.Query().Get("limit"), r.URL.Query().Get("skip")
+				writeJSON(w, http.StatusOK, `{"products":[{"id":1,"category":"beauty"},{"id":2,"category":"beauty"}],"total":7,"skip":5,"limit":2}`)
+			})
+			page, err := dc.GetProductsByCategory(t.Context(), tt.slug, &ListOptions{Limit: 2, Skip: 5})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if escapedPath != tt.wantPath {
+				t.Errorf("got request to %s, want %s", escapedPath, tt.wantPath)
+			}
+			if limit != "2" || skip != "5" {
+				t.Errorf("got limit %q and skip %q, want 2 and 5", limit, skip)
+			}
+			if got := ids(page.Products); !slices.Equal(got, []int{1, 2}) || page.Total != 7 {
+				t.Errorf("got products %v of %d, want [1 2] of 7", got, page.Total)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package dummyjson
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultUserAgent is sent when no user agent is configured.
+const DefaultUserAgent = "dummyjson-go"
+
+type DummyClient struct {
+	client *resty.Client
+	auth   authState
+	strict bool
+	// skipValidation disables the validation of the products sent
+	skipValidation bool
+	// thresholds check the availability status of the products sent, when
+	// set
+	thresholds *StockThresholds
+}
+
+// Option configures a DummyClient created by NewDummyClient.
+type Option func(*clientConfig)
+
+type clientConfig struct {
+	httpClient *http.Client
+	transport  http.RoundTripper
+	timeout    time.Duration
+	headers    map[string]string
+	userAgent  string
+	tlsConfig  *tls.Config
+	proxy      string
+	retry      RetryPolicy
+	strict     bool
+	validate   bool
+	thresholds *StockThresholds
+
+	username      string
+	password      string
+	tokens        Tokens
+	tokenLifetime time.Duration
+}
+
+// WithTimeout sets the timeout of every request, including reading the body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *clientConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) Option {
+	return func(cfg *clientConfig) {
+		cfg.headers[key] = value
+	}
+}
+
+// WithHeaders adds headers sent with every request.
+func WithHeaders(headers map[string]string) Option {
+	return func(cfg *clientConfig) {
+		for key, value := range headers {
+			cfg.headers[key] = value
+		}
+	}
+}
+
+// WithUserAgent replaces DefaultUserAgent.
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *clientConfig) {
+		cfg.userAgent = userAgent
+	}
+}
+
+// WithHTTPClient makes the client send its requests through a copy of
+// client, the other options do not change client nor its transport.
+func WithHTTPClient(client *http.Client) Option {
+	return func(cfg *clientConfig) {
+		cfg.httpClient = client
+	}
+}
+
+// WithTransport replaces the transport of the underlying http.Client.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(cfg *clientConfig) {
+		cfg.transport = transport
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the transport. It only applies
+// to *http.Transport, custom transports have to be configured by the caller.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(cfg *clientConfig) {
+		cfg.tlsConfig = config
+	}
+}
+
+// WithProxy sends every request through the proxy at proxyURL. Like
+// WithTLSConfig, it only applies to *http.Transport.
+func WithProxy(proxyURL string) Option {
+	return func(cfg *clientConfig) {
+		cfg.proxy = proxyURL
+	}
+}
+
+// WithStrictDecoding makes the client fail with an UnknownFieldsError when a
+// response holds fields that the models do not know, to notice changes of the
+// DummyJSON schema. By default these fields are kept in the Extra maps.
+func WithStrictDecoding() Option {
+	return func(cfg *clientConfig) {
+		cfg.strict = true
+	}
+}
+
+// WithoutValidation sends products to the server without running their
+// Validate method first, nor the check of WithAvailabilityCheck.
+func WithoutValidation() Option {
+	return func(cfg *clientConfig) {
+		cfg.validate = false
+	}
+}
+
+// WithAvailabilityCheck also refuses to send products whose availability
+// status contradicts their stock according to thresholds, see
+// Product.CheckAvailability.
+func WithAvailabilityCheck(thresholds StockThresholds) Option {
+	return func(cfg *clientConfig) {
+		cfg.thresholds = &thresholds
+	}
+}
+
+func NewDummyClient(url string, opts ...Option) *DummyClient {
+	cfg := clientConfig{
+		headers:   map[string]string{},
+		userAgent: DefaultUserAgent,
+		retry:     DefaultRetryPolicy,
+		validate:  true,
+
+		tokenLifetime: DefaultTokenLifetime,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var client *resty.Client
+	transport := cfg.transport
+	if cfg.httpClient != nil {
+		// Configure a copy, the caller's client may be shared such as
+		// http.DefaultClient
+		hc := *cfg.httpClient
+		client = resty.NewWithClient(&hc)
+		if transport == nil {
+			transport = cfg.httpClient.Transport
+		}
+	} else {
+		client = resty.New()
+	}
+	client.SetBaseURL(url)
+	// The TLS configuration and the proxy are set on the transport itself,
+	// leave the caller's one untouched
+	if t, ok := transport.(*http.Transport); ok && (cfg.tlsConfig != nil || cfg.proxy != "") {
+		transport = t.Clone()
+	}
+	if transport != nil {
+		client.SetTransport(transport)
+	}
+	if cfg.tlsConfig != nil {
+		client.SetTLSClientConfig(cfg.tlsConfig)
+	}
+	if cfg.proxy != "" {
+		client.SetProxy(cfg.proxy)
+	}
+	if cfg.timeout > 0 {
+		client.SetTimeout(cfg.timeout)
+	}
+	cfg.retry.apply(client)
+	client.SetHeader("User-Agent", cfg.userAgent)
+	client.SetHeaders(cfg.headers)
+
+	dc := &DummyClient{client: client, strict: cfg.strict, skipValidation: !cfg.validate, thresholds: cfg.thresholds}
+	dc.auth.username, dc.auth.password = cfg.username, cfg.password
+	dc.auth.lifetime = cfg.tokenLifetime
+	dc.auth.setTokens(cfg.tokens)
+	client.OnBeforeRequest(dc.authenticate)
+	return dc
+}
+
+// request starts a request bound to ctx.
+func (dc *DummyClient) request(ctx context.Context) *resty.Request {
+	return dc.client.R().SetContext(ctx)
+}
+
+// execute sends req and turns failures into errors. A request refused with
+// 401 is sent once more after renewing the tokens, when the client can. With
+// strict decoding, unknown fields in the result are an error.
+func (dc *DummyClient) execute(req *resty.Request, method, path string) error {
+	res, err := req.Execute(method, path)
+	if err == nil && res.StatusCode() == http.StatusUnauthorized && !skipAuth(req.Context()) && req.Token != "" {
+		if dc.reauthenticate(req.Context(), req.Token) == nil {
+			res, err = req.Execute(method, path)
+		}
+	}
+	if err := checkResponse(res, err); err != nil {
+		return err
+	}
+	if dc.strict && res.Result() != nil {
+		if fields := unknownFields(res.Body(), reflect.TypeOf(res.Result()), "", nil); len(fields) > 0 {
+			return UnknownFieldsError{Method: method, Path: path, Fields: fields}
+		}
+	}
+	return nil
+}
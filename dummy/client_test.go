@@ -0,0 +1,88 @@
+package dummyjson
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestClient starts a server running handler and returns a client for it
+// that does not retry, unless opts say otherwise.
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *DummyClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	opts = append([]Option{WithRetry(RetryPolicy{})}, opts...)
+	return NewDummyClient(srv.URL, opts...)
+}
+
+// writeJSON answers with status and the JSON document body.
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
+
+func TestWithHTTPClientLeavesCallerClientUntouched(t *testing.T) {
+	tests := map[string]func() *http.Client{
+		"nil transport": func() *http.Client {
+			return &http.Client{}
+		},
+		"http transport": func() *http.Client {
+			return &http.Client{Transport: &http.Transport{}}
+		},
+	}
+	for name, newClient := range tests {
+		t.Run(name, func(t *testing.T) {
+			hc := newClient()
+			transport := hc.Transport
+			NewDummyClient("http://dummyjson.invalid",
+				WithHTTPClient(hc),
+				WithTimeout(time.Second),
+				WithProxy("http://proxy.invalid:3128"),
+				WithTLSConfig(&tls.Config{ServerName: "dummyjson.invalid"}),
+			)
+			if hc.Timeout != 0 {
+				t.Errorf("timeout of the caller's client changed to %s", hc.Timeout)
+			}
+			if hc.Transport != transport {
+				t.Errorf("transport of the caller's client replaced by %T", hc.Transport)
+			}
+			// Cloning a transport sets up HTTP/2 on the original, which fills
+			// in its TLS configuration, only ours must not show up
+			if tr, ok := transport.(*http.Transport); ok {
+				if tr.Proxy != nil {
+					t.Error("proxy set on the caller's transport")
+				}
+				if tr.TLSClientConfig != nil && tr.TLSClientConfig.ServerName != "" {
+					t.Error("TLS configuration set on the caller's transport")
+				}
+			}
+		})
+	}
+}
+
+func TestWithHTTPClientSendsThroughCopy(t *testing.T) {
+	var called bool
+	hc := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"id":1}`)
+	}, WithHTTPClient(hc), WithTimeout(time.Second))
+	if _, err := dc.GetProduct(t.Context(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if !called {
+		t.Error("request not sent through the transport of the caller's client")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,112 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+type CommentResponse struct {
+	Comments []Comment `json:"comments"`
+	Pagination
+}
+
+func (r CommentResponse) items() []Comment       { return r.Comments }
+func (r CommentResponse) pagination() Pagination { return r.Pagination }
+
+type Comment struct {
+	Id        int         `json:"id"`
+	Body      string      `json:"body"`
+	PostId    int         `json:"postId"`
+	Likes     uint        `json:"likes"`
+	User      CommentUser `json:"user"`
+	IsDeleted bool        `json:"isDeleted,omitempty"`
+	DeletedOn Timestamp   `json:"deletedOn,omitzero"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type plain Comment
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type plain Comment
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// CommentUser is the author of a comment.
+type CommentUser struct {
+	Id       int    `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (cu *CommentUser) UnmarshalJSON(data []byte) error {
+	type plain CommentUser
+	return unmarshalWithExtra(data, (*plain)(cu), &cu.Extra)
+}
+
+func (cu CommentUser) MarshalJSON() ([]byte, error) {
+	type plain CommentUser
+	return marshalWithExtra(plain(cu), cu.Extra)
+}
+
+// GetComments walks every page of the comment listing, like GetProducts.
+func (dc *DummyClient) GetComments(ctx context.Context, opts *ListOptions) ([]Comment, error) {
+	return listAll[Comment, CommentResponse](ctx, dc, "/comments", nil, opts)
+}
+
+// CommentsSeq lazily walks the comment listing, like ProductsSeq.
+func (dc *DummyClient) CommentsSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Comment, error] {
+	return listSeq[Comment, CommentResponse](ctx, dc, "/comments", nil, opts)
+}
+
+func (dc *DummyClient) GetComment(ctx context.Context, id int) (Comment, error) {
+	var comment Comment
+	req := dc.request(ctx).SetResult(&comment)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/comments/%d", id)); err != nil {
+		return Comment{}, err
+	}
+	return comment, nil
+}
+
+// AddComment comments body on the post postId as the user userId.
+func (dc *DummyClient) AddComment(ctx context.Context, postId, userId int, body string) (Comment, error) {
+	var created Comment
+	req := dc.request(ctx).SetResult(&created).SetBody(map[string]any{
+		"body":   body,
+		"postId": postId,
+		"userId": userId,
+	})
+	if err := dc.execute(req, http.MethodPost, "/comments/add"); err != nil {
+		return Comment{}, err
+	}
+	return created, nil
+}
+
+// UpdateComment replaces the body of the comment.
+func (dc *DummyClient) UpdateComment(ctx context.Context, id int, body string) (Comment, error) {
+	var updated Comment
+	req := dc.request(ctx).SetResult(&updated).SetBody(map[string]any{
+		"body": body,
+	})
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/comments/%d", id)); err != nil {
+		return Comment{}, err
+	}
+	return updated, nil
+}
+
+func (dc *DummyClient) DeleteComment(ctx context.Context, id int) (Comment, error) {
+	var deleted Comment
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/comments/%d", id)); err != nil {
+		return Comment{}, err
+	}
+	return deleted, nil
+}
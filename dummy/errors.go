@@ -0,0 +1,110 @@
+package dummyjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Sentinel errors matched by errors.Is against a DummyError.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("resource not found")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// ErrUnknownFields is matched by errors.Is against an UnknownFieldsError.
+var ErrUnknownFields = errors.New("unknown fields")
+
+// DummyError is returned when DummyJSON answers with an error status.
+type DummyError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	// Message is the "message" field sent by the server, if any
+	Message string
+	Body    string
+}
+
+func (de DummyError) Error() string {
+	message := de.Message
+	if message == "" {
+		message = strings.TrimSpace(de.Body)
+	}
+	if message == "" {
+		message = http.StatusText(de.StatusCode)
+	}
+	return fmt.Sprintf("error from the server: %s %s returned %d: %s", de.Method, de.Path, de.StatusCode, message)
+}
+
+func (de DummyError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return de.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return de.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return de.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return de.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return de.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return de.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// UnknownFieldsError is returned by a client using WithStrictDecoding when a
+// response holds fields that the models do not know.
+type UnknownFieldsError struct {
+	Method string
+	Path   string
+	// Fields are the paths of the unknown fields, such as "reviews[0].likes"
+	Fields []string
+}
+
+func (ue UnknownFieldsError) Error() string {
+	return fmt.Sprintf("unknown fields in the response to %s %s: %s", ue.Method, ue.Path, strings.Join(ue.Fields, ", "))
+}
+
+func (ue UnknownFieldsError) Is(target error) bool {
+	return target == ErrUnknownFields
+}
+
+func newDummyError(res *resty.Response) DummyError {
+	de := DummyError{
+		StatusCode: res.StatusCode(),
+		Method:     res.Request.Method,
+		Path:       res.Request.URL,
+		Body:       string(res.Body()),
+	}
+	if res.Request.RawRequest != nil {
+		de.Path = res.Request.RawRequest.URL.Path
+	}
+	var body struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(res.Body(), &body) == nil {
+		de.Message = body.Message
+	}
+	return de
+}
+
+// checkResponse turns transport failures and error statuses into errors.
+func checkResponse(res *resty.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	if res.IsError() {
+		return newDummyError(res)
+	}
+	return nil
+}
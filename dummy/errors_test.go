@@ -0,0 +1,119 @@
+package dummyjson
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDummyError(t *testing.T) {
+	sentinels := []error{ErrBadRequest, ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited, ErrServer}
+	tests := map[string]struct {
+		status      int
+		contentType string
+		body        string
+		wantMessage string
+		wantError   string
+		wantIs      error
+	}{
+		"bad request": {
+			status: http.StatusBadRequest, body: `{"message":"Invalid query"}`,
+			wantMessage: "Invalid query",
+			wantError:   "error from the server: GET /products/search returned 400: Invalid query",
+			wantIs:      ErrBadRequest,
+		},
+		"unauthorized": {
+			status: http.StatusUnauthorized, body: `{"message":"Token Expired!"}`,
+			wantMessage: "Token Expired!",
+			wantError:   "error from the server: GET /products/search returned 401: Token Expired!",
+			wantIs:      ErrUnauthorized,
+		},
+		"forbidden": {
+			status: http.StatusForbidden, body: `{"message":"Forbidden"}`,
+			wantMessage: "Forbidden",
+			wantError:   "error from the server: GET /products/search returned 403: Forbidden",
+			wantIs:      ErrForbidden,
+		},
+		"not found": {
+			status: http.StatusNotFound, body: `{"message":"Product not found"}`,
+			wantMessage: "Product not found",
+			wantError:   "error from the server: GET /products/search returned 404: Product not found",
+			wantIs:      ErrNotFound,
+		},
+		"rate limited": {
+			status: http.StatusTooManyRequests, body: `{"message":"Slow down"}`,
+			wantMessage: "Slow down",
+			wantError:   "error from the server: GET /products/search returned 429: Slow down",
+			wantIs:      ErrRateLimited,
+		},
+		"server error": {
+			status: http.StatusInternalServerError, body: `{"message":"boom"}`,
+			wantMessage: "boom",
+			wantError:   "error from the server: GET /products/search returned 500: boom",
+			wantIs:      ErrServer,
+		},
+		"bad gateway": {
+			status: http.StatusBadGateway, body: `{}`,
+			wantError: "error from the server: GET /products/search returned 502: {}",
+			wantIs:    ErrServer,
+		},
+		"body without message": {
+			status: http.StatusServiceUnavailable, contentType: "text/plain", body: "  down for maintenance\n",
+			wantError: "error from the server: GET /products/search returned 503: down for maintenance",
+			wantIs:    ErrServer,
+		},
+		"empty body": {
+			status: http.StatusConflict, contentType: "text/plain",
+			wantError: "error from the server: GET /products/search returned 409: Conflict",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+					w.WriteHeader(tt.status)
+					w.Write([]byte(tt.body))
+					return
+				}
+				writeJSON(w, tt.status, tt.body)
+			})
+			_, err := dc.SearchProducts(t.Context(), "phone", &ListOptions{Limit: 5})
+			var dummyErr DummyError
+			if !errors.As(err, &dummyErr) {
+				t.Fatalf("got error %v, want a DummyError", err)
+			}
+			if dummyErr.StatusCode != tt.status || dummyErr.Method != http.MethodGet {
+				t.Errorf("got %s returning %d, want GET returning %d", dummyErr.Method, dummyErr.StatusCode, tt.status)
+			}
+			// The path comes from the request sent, without its query
+			if dummyErr.Path != "/products/search" {
+				t.Errorf("got path %q, want /products/search", dummyErr.Path)
+			}
+			if dummyErr.Message != tt.wantMessage {
+				t.Errorf("got message %q, want %q", dummyErr.Message, tt.wantMessage)
+			}
+			if dummyErr.Body != tt.body {
+				t.Errorf("got body %q, want %q", dummyErr.Body, tt.body)
+			}
+			if err.Error() != tt.wantError {
+				t.Errorf("got %q, want %q", err.Error(), tt.wantError)
+			}
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.wantIs) {
+					t.Errorf("errors.Is(err, %v) = %t, want %t", sentinel, got, sentinel == tt.wantIs)
+				}
+			}
+		})
+	}
+}
+
+func TestDummyErrorValue(t *testing.T) {
+	err := DummyError{StatusCode: http.StatusNotFound, Method: http.MethodGet, Path: "/products/1"}
+	if want := "error from the server: GET /products/1 returned 404: Not Found"; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	if errors.Is(err, ErrUnknownFields) || errors.Is(err, ErrInvalid) {
+		t.Error("DummyError matches the sentinels of other errors")
+	}
+}
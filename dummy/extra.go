@@ -0,0 +1,146 @@
+package dummyjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// knownFieldsCache maps a struct type to the types of its fields by their
+// lowercased JSON names.
+var knownFieldsCache sync.Map
+
+// knownFields returns the types of the fields of the struct type t by their
+// lowercased JSON names, encoding/json matching names regardless of their
+// case.
+func knownFields(t reflect.Type) map[string]reflect.Type {
+	if known, ok := knownFieldsCache.Load(t); ok {
+		return known.(map[string]reflect.Type)
+	}
+	known := make(map[string]reflect.Type)
+	addKnownFields(t, known)
+	knownFieldsCache.Store(t, known)
+	return known
+}
+
+func addKnownFields(t reflect.Type, known map[string]reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addKnownFields(field.Type, known)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		known[strings.ToLower(name)] = field.Type
+	}
+}
+
+// unmarshalWithExtra decodes data into v, a model without its own
+// UnmarshalJSON method, and stores the fields v does not know in extra.
+func unmarshalWithExtra[T any](data []byte, v *T, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		return err
+	}
+	known := knownFields(reflect.TypeFor[T]())
+	maps.DeleteFunc(fields, func(name string, _ json.RawMessage) bool {
+		return known[strings.ToLower(name)] != nil
+	})
+	*extra = nil
+	if len(fields) > 0 {
+		*extra = fields
+	}
+	return nil
+}
+
+// marshalWithExtra encodes v, a model without its own MarshalJSON method,
+// followed by the fields of extra it does not know.
+func marshalWithExtra[T any](v T, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	known := knownFields(reflect.TypeFor[T]())
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for _, name := range slices.Sorted(maps.Keys(extra)) {
+		if known[strings.ToLower(name)] != nil {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(extra[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unknownFields appends to found the paths of the fields of the JSON document
+// data that are not fields of t, the type it is decoded into. The document is
+// walked rather than the decoded value so that the types without an Extra
+// map, such as the listing envelopes, are checked too.
+func unknownFields(data []byte, t reflect.Type, path string, found []string) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return found
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if data[0] != '[' || json.Unmarshal(data, &items) != nil {
+			return found
+		}
+		for i, item := range items {
+			found = unknownFields(item, t.Elem(), path+"["+strconv.Itoa(i)+"]", found)
+		}
+	case reflect.Map, reflect.Struct:
+		var fields map[string]json.RawMessage
+		if data[0] != '{' || json.Unmarshal(data, &fields) != nil {
+			return found
+		}
+		for _, name := range slices.Sorted(maps.Keys(fields)) {
+			if t.Kind() == reflect.Map {
+				found = unknownFields(fields[name], t.Elem(), joinPath(path, name), found)
+				continue
+			}
+			field := knownFields(t)[strings.ToLower(name)]
+			if field == nil {
+				found = append(found, joinPath(path, name))
+				continue
+			}
+			found = unknownFields(fields[name], field, joinPath(path, name), found)
+		}
+	}
+	return found
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
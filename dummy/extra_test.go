@@ -0,0 +1,143 @@
+package dummyjson
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestExtraRoundTrip(t *testing.T) {
+	document := `{"id":1,"title":"Mascara","dimensions":{"width":1,"height":2,"depth":3,"unit":"cm"},` +
+		`"reviews":[{"rating":4,"comment":"ok","reviewerName":"a","reviewerEmail":"a@x","likes":3}],"color":"black"}`
+	var product Product
+	if err := json.Unmarshal([]byte(document), &product); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(product.Extra["color"]); got != `"black"` {
+		t.Errorf("got color %s, want \"black\"", got)
+	}
+	if got := string(product.Dimensions.Extra["unit"]); got != `"cm"` {
+		t.Errorf("got unit %s, want \"cm\"", got)
+	}
+	if got := string(product.Reviews[0].Extra["likes"]); got != "3" {
+		t.Errorf("got likes %s, want 3", got)
+	}
+	encoded, err := json.Marshal(product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields struct {
+		Color      string         `json:"color"`
+		Dimensions map[string]any `json:"dimensions"`
+		Reviews    []map[string]any
+	}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields.Color != "black" || fields.Dimensions["unit"] != "cm" || fields.Reviews[0]["likes"] != 3.0 {
+		t.Errorf("extra fields lost in %s", encoded)
+	}
+	var again Product
+	if err := json.Unmarshal(encoded, &again); err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Extra) != 1 || len(again.Dimensions.Extra) != 1 || len(again.Reviews[0].Extra) != 1 {
+		t.Errorf("got extra fields %v, %v and %v after a round trip", again.Extra, again.Dimensions.Extra, again.Reviews[0].Extra)
+	}
+}
+
+func TestExtraDoesNotOverrideFields(t *testing.T) {
+	product := Product{Id: 1, Title: "Mascara", Extra: map[string]json.RawMessage{"title": []byte(`"stale"`)}}
+	encoded, err := json.Marshal(product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Product
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Title != "Mascara" || decoded.Extra != nil {
+		t.Errorf("got title %q and extra %v from %s", decoded.Title, decoded.Extra, encoded)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	tests := map[string]struct {
+		documents map[string]string
+		call      func(dc *DummyClient) error
+		want      []string
+	}{
+		"known fields": {
+			documents: map[string]string{"/products/1": `{"id":1,"Title":"case does not matter","dimensions":{"width":1},"meta":{"createdAt":"2024-05-23T08:56:21.618Z"}}`},
+			call: func(dc *DummyClient) error {
+				_, err := dc.GetProduct(t.Context(), 1)
+				return err
+			},
+		},
+		"nested fields": {
+			documents: map[string]string{"/products/1": `{"id":1,"color":"black","dimensions":{"unit":"cm"},"reviews":[{"rating":5},{"likes":3}]}`},
+			call: func(dc *DummyClient) error {
+				_, err := dc.GetProduct(t.Context(), 1)
+				return err
+			},
+			want: []string{"color", "dimensions.unit", "reviews[1].likes"},
+		},
+		"listing envelope": {
+			documents: map[string]string{"/products/search": `{"products":[{"id":1,"color":"black"}],"total":1,"skip":0,"limit":1,"next":"/products?skip=1"}`},
+			call: func(dc *DummyClient) error {
+				_, err := dc.SearchProducts(t.Context(), "mascara", nil)
+				return err
+			},
+			want: []string{"next", "products[0].color"},
+		},
+		"login": {
+			documents: map[string]string{"/auth/login": `{"id":1,"username":"emilys","accessToken":"a","refreshToken":"r","role":"admin"}`},
+			call: func(dc *DummyClient) error {
+				_, err := dc.Login(t.Context(), "emilys", "secret")
+				return err
+			},
+			want: []string{"role"},
+		},
+		"tokens": {
+			documents: map[string]string{
+				"/auth/login":   `{"id":1,"username":"emilys","accessToken":"a","refreshToken":"r"}`,
+				"/auth/refresh": `{"accessToken":"a","refreshToken":"r","expiresIn":3600}`,
+			},
+			call: func(dc *DummyClient) error {
+				if _, err := dc.Login(t.Context(), "emilys", "secret"); err != nil {
+					return err
+				}
+				_, err := dc.Refresh(t.Context())
+				return err
+			},
+			want: []string{"expiresIn"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				document, ok := tt.documents[r.URL.Path]
+				if !ok {
+					t.Errorf("got unexpected request to %s", r.URL.Path)
+				}
+				writeJSON(w, http.StatusOK, document)
+			}, WithStrictDecoding())
+			err := tt.call(dc)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("got error %v, want none", err)
+				}
+				return
+			}
+			var unknown UnknownFieldsError
+			if !errors.As(err, &unknown) || !errors.Is(err, ErrUnknownFields) {
+				t.Fatalf("got error %v, want an UnknownFieldsError", err)
+			}
+			if !slices.Equal(unknown.Fields, tt.want) {
+				t.Errorf("got fields %v, want %v", unknown.Fields, tt.want)
+			}
+		})
+	}
+}
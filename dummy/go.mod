@@ -1,6 +1,6 @@
 module demo.null/dummy
 
-go 1.22.3
+go 1.24.0
 
 require github.com/go-resty/resty/v2 v2.13.1
 
@@ -0,0 +1,260 @@
+package dummyjson
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultPageSize is the number of items requested per page when
+// ListOptions.Limit is zero.
+const DefaultPageSize = 30
+
+// LimitAll makes ListOptions.Limit request a whole listing in one call.
+const LimitAll = -1
+
+// Pagination is the part of a listing response locating its page.
+type Pagination struct {
+	Total uint `json:"total"`
+	Skip  uint `json:"skip"`
+	Limit uint `json:"limit"`
+}
+
+// listPage is implemented by listing responses such as ProductResponse.
+type listPage[T any] interface {
+	items() []T
+	pagination() Pagination
+}
+
+// selectable is implemented by items that remember the fields selected by
+// ListOptions.Select.
+type selectable interface {
+	setSelected(fields []string)
+}
+
+// SortOrder is the direction of ListOptions.SortBy.
+type SortOrder string
+
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// ProgressFunc is called after every page with the number of items fetched
+// so far and the number of items the listing will return in total.
+type ProgressFunc func(fetched, total int)
+
+// ListOptions controls how listings are paginated. A nil *ListOptions uses
+// the defaults.
+type ListOptions struct {
+	// Limit is the number of items requested per page, DefaultPageSize when
+	// zero. LimitAll sends limit=0, which returns everything at once.
+	Limit int
+	// Skip is the number of items skipped before the first page.
+	Skip int
+	// SortBy is the JSON name of the field the server sorts by.
+	SortBy string
+	Order  SortOrder
+	// Select restricts the returned fields to these JSON names, the id is
+	// always returned. Products listed this way are sparse, see Product.Has.
+	Select []string
+	// Progress, if set, reports how far the listing has got. It is never
+	// called concurrently.
+	Progress ProgressFunc
+	// Concurrency is the number of pages fetched in parallel by the methods
+	// returning a whole listing, once the first page has reported the total.
+	// Values below 2 fetch the pages one by one.
+	Concurrency int
+}
+
+func (o *ListOptions) limit() int {
+	switch {
+	case o == nil || o.Limit == 0:
+		return DefaultPageSize
+	case o.Limit < 0:
+		return 0
+	}
+	return o.Limit
+}
+
+func (o *ListOptions) skip() int {
+	if o == nil || o.Skip < 0 {
+		return 0
+	}
+	return o.Skip
+}
+
+func (o *ListOptions) concurrency() int {
+	if o == nil {
+		return 1
+	}
+	return max(o.Concurrency, 1)
+}
+
+func (o *ListOptions) progress(fetched, total int) {
+	if o != nil && o.Progress != nil {
+		o.Progress(fetched, total)
+	}
+}
+
+// pageParams returns the query parameters of the page starting at skip.
+func (o *ListOptions) pageParams(skip int) map[string]string {
+	params := map[string]string{
+		"limit": strconv.Itoa(o.limit()),
+		"skip":  strconv.Itoa(skip),
+	}
+	if o == nil {
+		return params
+	}
+	if o.SortBy != "" {
+		params["sortBy"] = o.SortBy
+	}
+	if o.Order != "" {
+		params["order"] = string(o.Order)
+	}
+	if len(o.Select) > 0 {
+		params["select"] = strings.Join(o.Select, ",")
+	}
+	return params
+}
+
+// getPage fetches the page of the listing at path starting at skip, sending
+// query along with the paging parameters.
+func getPage[T any, P listPage[T]](ctx context.Context, dc *DummyClient, path string, query map[string]string, opts *ListOptions, skip int) (P, error) {
+	var page P
+	req := dc.request(ctx).SetQueryParams(query).SetQueryParams(opts.pageParams(skip)).SetResult(&page)
+	if err := dc.execute(req, http.MethodGet, path); err != nil {
+		var zero P
+		return zero, err
+	}
+	if opts != nil && len(opts.Select) > 0 {
+		selected := slices.Clone(opts.Select)
+		items := page.items()
+		for i := range items {
+			if item, ok := any(&items[i]).(selectable); ok {
+				item.setSelected(selected)
+			}
+		}
+	}
+	return page, nil
+}
+
+// listSeq lazily walks the listing at path.
+func listSeq[T any, P listPage[T]](ctx context.Context, dc *DummyClient, path string, query map[string]string, opts *ListOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		skip, fetched := opts.skip(), 0
+		for {
+			// Stop walking the pages as soon as the caller gives up
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := getPage[T, P](ctx, dc, path, query, opts, skip)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			items, pagination := page.items(), page.pagination()
+			fetched += len(items)
+			opts.progress(fetched, max(int(pagination.Total)-opts.skip(), 0))
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			skip = int(pagination.Skip) + len(items)
+			if len(items) == 0 || skip >= int(pagination.Total) {
+				return
+			}
+		}
+	}
+}
+
+// listAll returns every item of the listing at path, fetching the pages
+// concurrently when opts asks for it.
+func listAll[T any, P listPage[T]](ctx context.Context, dc *DummyClient, path string, query map[string]string, opts *ListOptions) ([]T, error) {
+	if opts.concurrency() > 1 {
+		return listConcurrently[T, P](ctx, dc, path, query, opts)
+	}
+	var all []T
+	for item, err := range listSeq[T, P](ctx, dc, path, query, opts) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+// listConcurrently fetches the first page to learn the total, then the
+// remaining pages with a pool of opts.Concurrency workers. The first failing
+// page cancels the others.
+func listConcurrently[T any, P listPage[T]](ctx context.Context, dc *DummyClient, path string, query map[string]string, opts *ListOptions) ([]T, error) {
+	first, err := getPage[T, P](ctx, dc, path, query, opts, opts.skip())
+	if err != nil {
+		return nil, err
+	}
+	firstItems, pagination := first.items(), first.pagination()
+	total := max(int(pagination.Total)-opts.skip(), 0)
+	fetched := len(firstItems)
+	opts.progress(fetched, total)
+
+	// The server may return less than asked for, so follow its page size
+	var skips []int
+	if pageSize := len(firstItems); pageSize > 0 {
+		for skip := int(pagination.Skip) + pageSize; skip < int(pagination.Total); skip += pageSize {
+			skips = append(skips, skip)
+		}
+	}
+	pages := make([][]T, len(skips))
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	jobs := make(chan int)
+	for range min(opts.concurrency(), len(skips)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				page, err := getPage[T, P](ctx, dc, path, query, opts, skips[i])
+				if err != nil {
+					cancel(err)
+					return
+				}
+				pages[i] = page.items()
+				mu.Lock()
+				fetched += len(pages[i])
+				opts.progress(fetched, total)
+				mu.Unlock()
+			}
+		}()
+	}
+feed:
+	for i := range skips {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+
+	all := make([]T, 0, fetched)
+	all = append(all, firstItems...)
+	for _, page := range pages {
+		all = append(all, page...)
+	}
+	return all, nil
+}
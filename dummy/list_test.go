@@ -0,0 +1,371 @@
+package dummyjson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// listServer serves a product listing of total products with the ids 1 to
+// total, paginated like DummyJSON.
+type listServer struct {
+	total int
+	// maxLimit clamps the page size when set, like DummyJSON does for large
+	// limits
+	maxLimit int
+	// missing is the number of products at the end of the listing that are
+	// counted in the total but never returned
+	missing int
+	// page, when set, is called before a page is served and answers instead
+	// of the listing when it returns false
+	page func(w http.ResponseWriter, r *http.Request, skip int) bool
+
+	calls atomic.Int32
+}
+
+func (s *listServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.calls.Add(1)
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+	if s.page != nil && !s.page(w, r, skip) {
+		return
+	}
+	available := s.total - s.missing
+	if limit == 0 || limit > available {
+		limit = available
+	}
+	if s.maxLimit > 0 {
+		limit = min(limit, s.maxLimit)
+	}
+	var products []string
+	for id := skip + 1; id <= min(skip+limit, available); id++ {
+		products = append(products, fmt.Sprintf(`{"id":%d}`, id))
+	}
+	writeJSON(w, http.StatusOK, fmt.Sprintf(`{"products":[%s],"total":%d,"skip":%d,"limit":%d}`,
+		strings.Join(products, ","), s.total, skip, len(products)))
+}
+
+// ids returns the ids of products.
+func ids(products []Product) []int {
+	ids := make([]int, len(products))
+	for i, product := range products {
+		ids[i] = product.Id
+	}
+	return ids
+}
+
+// idRange returns the ids from first to last.
+func idRange(first, last int) []int {
+	var ids []int
+	for id := first; id <= last; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+type progressCall struct{ fetched, total int }
+
+func TestProductsSeq(t *testing.T) {
+	tests := map[string]struct {
+		server       *listServer
+		opts         ListOptions
+		wantIds      []int
+		wantCalls    int32
+		wantProgress []progressCall
+	}{
+		"default page size": {
+			server:       &listServer{total: 65},
+			wantIds:      idRange(1, 65),
+			wantCalls:    3,
+			wantProgress: []progressCall{{30, 65}, {60, 65}, {65, 65}},
+		},
+		"exact pages": {
+			server:       &listServer{total: 60},
+			opts:         ListOptions{Limit: 20},
+			wantIds:      idRange(1, 60),
+			wantCalls:    3,
+			wantProgress: []progressCall{{20, 60}, {40, 60}, {60, 60}},
+		},
+		"limit all": {
+			server:       &listServer{total: 65},
+			opts:         ListOptions{Limit: LimitAll},
+			wantIds:      idRange(1, 65),
+			wantCalls:    1,
+			wantProgress: []progressCall{{65, 65}},
+		},
+		// Regression: the listing used to stop at the first page that did not
+		// hold 30 products
+		"server clamps the page size": {
+			server:       &listServer{total: 25, maxLimit: 10},
+			opts:         ListOptions{Limit: 20},
+			wantIds:      idRange(1, 25),
+			wantCalls:    3,
+			wantProgress: []progressCall{{10, 25}, {20, 25}, {25, 25}},
+		},
+		"skip": {
+			server:       &listServer{total: 65},
+			opts:         ListOptions{Limit: 30, Skip: 20},
+			wantIds:      idRange(21, 65),
+			wantCalls:    2,
+			wantProgress: []progressCall{{30, 45}, {45, 45}},
+		},
+		"skip past the end": {
+			server:       &listServer{total: 10},
+			opts:         ListOptions{Skip: 20},
+			wantCalls:    1,
+			wantProgress: []progressCall{{0, 0}},
+		},
+		"empty listing": {
+			server:       &listServer{},
+			wantCalls:    1,
+			wantProgress: []progressCall{{0, 0}},
+		},
+		// The total counts products that are never returned, the empty page
+		// must end the listing instead of requesting it forever
+		"total larger than the products": {
+			server:       &listServer{total: 30, missing: 5},
+			opts:         ListOptions{Limit: 10},
+			wantIds:      idRange(1, 25),
+			wantCalls:    4,
+			wantProgress: []progressCall{{10, 30}, {20, 30}, {25, 30}, {25, 30}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var progress []progressCall
+			opts := tt.opts
+			opts.Progress = func(fetched, total int) {
+				progress = append(progress, progressCall{fetched, total})
+			}
+			dc := newTestClient(t, tt.server.ServeHTTP)
+			var products []Product
+			for product, err := range dc.ProductsSeq(t.Context(), &opts) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				products = append(products, product)
+			}
+			if got := ids(products); !slices.Equal(got, tt.wantIds) {
+				t.Errorf("got ids %v, want %v", got, tt.wantIds)
+			}
+			if got := tt.server.calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+			if !slices.Equal(progress, tt.wantProgress) {
+				t.Errorf("got progress %v, want %v", progress, tt.wantProgress)
+			}
+		})
+	}
+}
+
+func TestProductsSeqStopsWithCaller(t *testing.T) {
+	server := listServer{total: 100}
+	dc := newTestClient(t, server.ServeHTTP)
+	var seen int
+	for _, err := range dc.ProductsSeq(t.Context(), &ListOptions{Limit: 10}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen++; seen == 15 {
+			break
+		}
+	}
+	if got := server.calls.Load(); got != 2 {
+		t.Errorf("got %d calls, want 2", got)
+	}
+}
+
+func TestProductsSeqYieldsError(t *testing.T) {
+	server := listServer{total: 100, page: func(w http.ResponseWriter, r *http.Request, skip int) bool {
+		if skip == 10 {
+			writeJSON(w, http.StatusInternalServerError, `{"message":"boom"}`)
+			return false
+		}
+		return true
+	}}
+	dc := newTestClient(t, server.ServeHTTP)
+	var seen int
+	var lastErr error
+	for product, err := range dc.ProductsSeq(t.Context(), &ListOptions{Limit: 10}) {
+		if err != nil {
+			lastErr = err
+			if product.Id != 0 {
+				t.Errorf("got product %d with the error, want a zero product", product.Id)
+			}
+			continue
+		}
+		seen++
+	}
+	if seen != 10 {
+		t.Errorf("got %d products before the error, want 10", seen)
+	}
+	if lastErr == nil || !strings.Contains(lastErr.Error(), "boom") {
+		t.Errorf("got error %v, want the server error", lastErr)
+	}
+}
+
+func TestGetProducts(t *testing.T) {
+	server := listServer{total: 65, maxLimit: 25}
+	dc := newTestClient(t, server.ServeHTTP)
+	products, err := dc.GetProducts(t.Context(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ids(products), idRange(1, 65); !slices.Equal(got, want) {
+		t.Errorf("got ids %v, want %v", got, want)
+	}
+	// Regression: an unparameterised request used to precede the pages
+	if got := server.calls.Load(); got != 3 {
+		t.Errorf("got %d calls, want 3", got)
+	}
+}
+
+// blockPage holds a page until the client gives up on it, or long enough for
+// the test to notice the listing did not stop.
+func blockPage(r *http.Request) {
+	select {
+	case <-r.Context().Done():
+	case <-time.After(5 * time.Second):
+	}
+}
+
+func TestGetProductsConcurrently(t *testing.T) {
+	tests := map[string]struct {
+		server    *listServer
+		opts      ListOptions
+		wantIds   []int
+		wantCalls int32
+	}{
+		// The pages follow the size of the first one
+		"server clamps the page size": {
+			server:    &listServer{total: 97, maxLimit: 7},
+			opts:      ListOptions{Limit: 30},
+			wantIds:   idRange(1, 97),
+			wantCalls: 14,
+		},
+		"skip": {
+			server:    &listServer{total: 97},
+			opts:      ListOptions{Limit: 10, Skip: 15},
+			wantIds:   idRange(16, 97),
+			wantCalls: 9,
+		},
+		"limit all": {
+			server:    &listServer{total: 97},
+			opts:      ListOptions{Limit: LimitAll},
+			wantIds:   idRange(1, 97),
+			wantCalls: 1,
+		},
+		"fewer pages than workers": {
+			server:    &listServer{total: 15},
+			opts:      ListOptions{Limit: 10},
+			wantIds:   idRange(1, 15),
+			wantCalls: 2,
+		},
+		"empty listing": {
+			server:    &listServer{},
+			wantCalls: 1,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Answer the later pages first so that they complete out of order
+			tt.server.page = func(w http.ResponseWriter, r *http.Request, skip int) bool {
+				time.Sleep(time.Duration(tt.server.total-skip) * 50 * time.Microsecond)
+				return true
+			}
+			var progress []progressCall
+			opts := tt.opts
+			opts.Concurrency = 4
+			opts.Progress = func(fetched, total int) {
+				progress = append(progress, progressCall{fetched, total})
+			}
+			dc := newTestClient(t, tt.server.ServeHTTP)
+			products, err := dc.GetProducts(t.Context(), &opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ids(products); !slices.Equal(got, tt.wantIds) {
+				t.Errorf("got ids %v, want %v", got, tt.wantIds)
+			}
+			if got := tt.server.calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+			if len(progress) != int(tt.wantCalls) {
+				t.Fatalf("got %d progress calls, want one per page", len(progress))
+			}
+			for i, call := range progress {
+				if i > 0 && call.fetched <= progress[i-1].fetched && len(tt.wantIds) > 0 {
+					t.Errorf("progress went from %v to %v", progress[i-1], call)
+				}
+				if call.total != len(tt.wantIds) {
+					t.Errorf("got progress total %d, want %d", call.total, len(tt.wantIds))
+				}
+			}
+		})
+	}
+}
+
+func TestGetProductsConcurrentlyStopsAtFirstError(t *testing.T) {
+	server := &listServer{total: 100, page: func(w http.ResponseWriter, r *http.Request, skip int) bool {
+		switch skip {
+		case 0:
+			return true
+		case 10:
+			writeJSON(w, http.StatusInternalServerError, `{"message":"boom"}`)
+			return false
+		}
+		blockPage(r)
+		return true
+	}}
+	dc := newTestClient(t, server.ServeHTTP)
+	start := time.Now()
+	products, err := dc.GetProducts(t.Context(), &ListOptions{Limit: 10, Concurrency: 4})
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("got error %v, want %v", err, ErrServer)
+	}
+	if products != nil {
+		t.Errorf("got %d products with the error, want none", len(products))
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("returned after %s, the other workers were not cancelled", elapsed)
+	}
+	// The first page, the failing one and at most one page per other worker
+	if got := server.calls.Load(); got > 5 {
+		t.Errorf("got %d calls, want the listing to stop after the error", got)
+	}
+}
+
+func TestGetProductsConcurrentlyStopsWithParent(t *testing.T) {
+	server := &listServer{total: 100, page: func(w http.ResponseWriter, r *http.Request, skip int) bool {
+		if skip > 0 {
+			blockPage(r)
+		}
+		return true
+	}}
+	dc := newTestClient(t, server.ServeHTTP)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	start := time.Now()
+	_, err := dc.GetProducts(ctx, &ListOptions{
+		Limit:       10,
+		Concurrency: 4,
+		// Give up once the workers are started
+		Progress: func(fetched, total int) { cancel() },
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("returned after %s, the workers did not stop with the parent context", elapsed)
+	}
+	if got := server.calls.Load(); got > 5 {
+		t.Errorf("got %d calls, want the listing to stop once cancelled", got)
+	}
+}
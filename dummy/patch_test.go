@@ -0,0 +1,107 @@
+package dummyjson
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// patchServer records the keys of the body of a PATCH request, the keys of
+// nested objects prefixed with the key of the object, and answers with an
+// empty object.
+func patchServer(t *testing.T, keys *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("got method %s, want %s", r.Method, http.MethodPatch)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			t.Errorf("got body %s: %v", body, err)
+		}
+		*keys = nil
+		for key, value := range fields {
+			var nested map[string]json.RawMessage
+			if json.Unmarshal(value, &nested) != nil {
+				*keys = append(*keys, key)
+				continue
+			}
+			for nestedKey := range nested {
+				*keys = append(*keys, key+"."+nestedKey)
+			}
+		}
+		slices.Sort(*keys)
+		writeJSON(w, http.StatusOK, `{}`)
+	}
+}
+
+func TestUpdateSendsOnlyPatchedFields(t *testing.T) {
+	tests := map[string]struct {
+		update func(dc *DummyClient) error
+		want   []string
+	}{
+		"product": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.UpdateProduct(t.Context(), 1, ProductPatch{Price: Ptr(0.0), Tags: Ptr([]string{}), Images: Ptr([]string{})})
+				return err
+			},
+			want: []string{"images", "price", "tags"},
+		},
+		"product dimension": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.UpdateProduct(t.Context(), 1, ProductPatch{Dimensions: &DimensionPatch{Width: Ptr(1.0)}})
+				return err
+			},
+			want: []string{"dimensions.width"},
+		},
+		"user": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.UpdateUser(t.Context(), 1, UserPatch{LastName: Ptr("Owais"), Age: Ptr(uint(0))})
+				return err
+			},
+			want: []string{"age", "lastName"},
+		},
+		"post": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.UpdatePost(t.Context(), 1, PostPatch{Title: Ptr("Edited"), Tags: Ptr([]string{})})
+				return err
+			},
+			want: []string{"tags", "title"},
+		},
+		"todo": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.UpdateTodo(t.Context(), 1, TodoPatch{Todo: Ptr("Walk the dog")})
+				return err
+			},
+			want: []string{"todo"},
+		},
+		"todo completed": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.SetTodoCompleted(t.Context(), 1, false)
+				return err
+			},
+			want: []string{"completed"},
+		},
+		"recipe": {
+			update: func(dc *DummyClient) error {
+				_, err := dc.UpdateRecipe(t.Context(), 1, RecipePatch{Servings: Ptr(uint(6)), MealType: Ptr([]string{"Dinner"})})
+				return err
+			},
+			want: []string{"mealType", "servings"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var keys []string
+			dc := newTestClient(t, patchServer(t, &keys))
+			if err := tt.update(dc); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(keys, tt.want) {
+				t.Errorf("got fields %v, want %v", keys, tt.want)
+			}
+		})
+	}
+}
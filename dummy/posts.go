@@ -0,0 +1,138 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+type PostResponse struct {
+	Posts []Post `json:"posts"`
+	Pagination
+}
+
+func (r PostResponse) items() []Post          { return r.Posts }
+func (r PostResponse) pagination() Pagination { return r.Pagination }
+
+type Post struct {
+	Id        int       `json:"id"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Tags      []string  `json:"tags"`
+	Reactions Reactions `json:"reactions"`
+	Views     uint      `json:"views"`
+	UserId    int       `json:"userId"`
+	IsDeleted bool      `json:"isDeleted,omitempty"`
+	DeletedOn Timestamp `json:"deletedOn,omitzero"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *Post) UnmarshalJSON(data []byte) error {
+	type plain Post
+	return unmarshalWithExtra(data, (*plain)(p), &p.Extra)
+}
+
+func (p Post) MarshalJSON() ([]byte, error) {
+	type plain Post
+	return marshalWithExtra(plain(p), p.Extra)
+}
+
+// PostPatch is a partial update of a post, like ProductPatch.
+type PostPatch struct {
+	Title     *string    `json:"title,omitempty"`
+	Body      *string    `json:"body,omitempty"`
+	Tags      *[]string  `json:"tags,omitempty"`
+	Reactions *Reactions `json:"reactions,omitempty"`
+	Views     *uint      `json:"views,omitempty"`
+	UserId    *int       `json:"userId,omitempty"`
+}
+
+type Reactions struct {
+	Likes    uint `json:"likes"`
+	Dislikes uint `json:"dislikes"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Reactions) UnmarshalJSON(data []byte) error {
+	type plain Reactions
+	return unmarshalWithExtra(data, (*plain)(r), &r.Extra)
+}
+
+func (r Reactions) MarshalJSON() ([]byte, error) {
+	type plain Reactions
+	return marshalWithExtra(plain(r), r.Extra)
+}
+
+// GetPosts walks every page of the post listing, like GetProducts.
+func (dc *DummyClient) GetPosts(ctx context.Context, opts *ListOptions) ([]Post, error) {
+	return listAll[Post, PostResponse](ctx, dc, "/posts", nil, opts)
+}
+
+// PostsSeq lazily walks the post listing, like ProductsSeq.
+func (dc *DummyClient) PostsSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Post, error] {
+	return listSeq[Post, PostResponse](ctx, dc, "/posts", nil, opts)
+}
+
+// SearchPosts returns the page of posts matching query selected by opts.
+func (dc *DummyClient) SearchPosts(ctx context.Context, query string, opts *ListOptions) (PostResponse, error) {
+	return getPage[Post, PostResponse](ctx, dc, "/posts/search", map[string]string{"q": query}, opts, opts.skip())
+}
+
+// GetPostsByTag returns the page of posts tagged with tag selected by opts.
+func (dc *DummyClient) GetPostsByTag(ctx context.Context, tag string, opts *ListOptions) (PostResponse, error) {
+	return getPage[Post, PostResponse](ctx, dc, "/posts/tag/"+url.PathEscape(tag), nil, opts, opts.skip())
+}
+
+// GetPostsByUser returns the page of posts of the user userId selected by
+// opts.
+func (dc *DummyClient) GetPostsByUser(ctx context.Context, userId int, opts *ListOptions) (PostResponse, error) {
+	return getPage[Post, PostResponse](ctx, dc, fmt.Sprintf("/posts/user/%d", userId), nil, opts, opts.skip())
+}
+
+// GetPostComments returns the page of comments on the post postId selected
+// by opts.
+func (dc *DummyClient) GetPostComments(ctx context.Context, postId int, opts *ListOptions) (CommentResponse, error) {
+	return getPage[Comment, CommentResponse](ctx, dc, fmt.Sprintf("/posts/%d/comments", postId), nil, opts, opts.skip())
+}
+
+func (dc *DummyClient) GetPost(ctx context.Context, id int) (Post, error) {
+	var post Post
+	req := dc.request(ctx).SetResult(&post)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/posts/%d", id)); err != nil {
+		return Post{}, err
+	}
+	return post, nil
+}
+
+func (dc *DummyClient) AddPost(ctx context.Context, post Post) (Post, error) {
+	var created Post
+	req := dc.request(ctx).SetBody(post).SetResult(&created)
+	if err := dc.execute(req, http.MethodPost, "/posts/add"); err != nil {
+		return Post{}, err
+	}
+	return created, nil
+}
+
+// UpdatePost changes the fields set in patch and returns the updated post.
+func (dc *DummyClient) UpdatePost(ctx context.Context, id int, patch PostPatch) (Post, error) {
+	var updated Post
+	req := dc.request(ctx).SetBody(patch).SetResult(&updated)
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/posts/%d", id)); err != nil {
+		return Post{}, err
+	}
+	return updated, nil
+}
+
+func (dc *DummyClient) DeletePost(ctx context.Context, id int) (Post, error) {
+	var deleted Post
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/posts/%d", id)); err != nil {
+		return Post{}, err
+	}
+	return deleted, nil
+}
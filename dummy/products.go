@@ -1,158 +1,247 @@
 package dummyjson
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"strconv"
-	"time"
-
-	"github.com/go-resty/resty/v2"
+	"iter"
+	"net/http"
+	"slices"
 )
 
 type ProductResponse struct {
 	Products []Product `json:"products"`
-	Total    uint      `json:"total"`
-	Skip     uint      `json:"skip"`
-	Limit    uint      `json:"limit"`
+	Pagination
 }
 
+func (r ProductResponse) items() []Product       { return r.Products }
+func (r ProductResponse) pagination() Pagination { return r.Pagination }
+
 type Product struct {
-	Id                   int       `json:"id"`
-	Title                string    `json:"title"`
-	Description          string    `json:"description"`
-	Category             string    `json:"category"`
-	Price                float64   `json:"price"`
-	DiscountPercentage   float64   `json:"discountPercentage"`
-	Rating               float64   `json:"rating"`
-	Stock                uint      `json:"stock"`
-	Tags                 []string  `json:"tags"`
-	Brand                string    `json:"brand"`
-	Sku                  string    `json:"sku"`
-	Weight               float64   `json:"weight"`
-	Dimensions           Dimension `json:"dimensions"`
-	WarrantyInfo         string    `json:"warrantyInformation"`
-	ShippingInfo         string    `json:"shippingInformation"`
-	AvailabilityStatus   string    `json:"availabilityStatus"`
-	Reviews              []Review  `json:"reviews"`
-	ReturnPolicy         string    `json:"returnPolicy"`
-	MinimumOrderQuantity uint      `json:"minimumOrderQuantity"`
-	Meta                 Meta      `json:"meta"`
-	Thumbnail            string    `json:"thumbnail"`
-	Images               []string  `json:"images"`
-	IsDeleted            bool      `json:"isDeleted,omitempty"`
-	DeletedOn            time.Time `json:"deletedOn,omitempty"`
+	Id                   int                `json:"id"`
+	Title                string             `json:"title"`
+	Description          string             `json:"description"`
+	Category             string             `json:"category"`
+	Price                float64            `json:"price"`
+	DiscountPercentage   float64            `json:"discountPercentage"`
+	Rating               float64            `json:"rating"`
+	Stock                uint               `json:"stock"`
+	Tags                 []string           `json:"tags"`
+	Brand                string             `json:"brand"`
+	Sku                  string             `json:"sku"`
+	Weight               float64            `json:"weight"`
+	Dimensions           Dimension          `json:"dimensions"`
+	WarrantyInfo         string             `json:"warrantyInformation"`
+	ShippingInfo         string             `json:"shippingInformation"`
+	AvailabilityStatus   AvailabilityStatus `json:"availabilityStatus"`
+	Reviews              []Review           `json:"reviews"`
+	ReturnPolicy         string             `json:"returnPolicy"`
+	MinimumOrderQuantity uint               `json:"minimumOrderQuantity"`
+	Meta                 Meta               `json:"meta"`
+	Thumbnail            string             `json:"thumbnail"`
+	Images               []string           `json:"images"`
+	IsDeleted            bool               `json:"isDeleted,omitempty"`
+	DeletedOn            Timestamp          `json:"deletedOn,omitzero"`
+
+	// Extra holds the fields sent by the server that Product does not model.
+	// They are sent back when the product is encoded.
+	Extra map[string]json.RawMessage `json:"-"`
+
+	// selected lists the fields returned by a listing using
+	// ListOptions.Select, nil when every field was returned.
+	selected []string
+}
+
+func (p *Product) UnmarshalJSON(data []byte) error {
+	type plain Product
+	return unmarshalWithExtra(data, (*plain)(p), &p.Extra)
+}
+
+func (p Product) MarshalJSON() ([]byte, error) {
+	type plain Product
+	return marshalWithExtra(plain(p), p.Extra)
+}
+
+func (p *Product) setSelected(fields []string) {
+	p.selected = fields
+}
+
+// IsSparse reports whether the product comes from a listing returning only
+// some of its fields.
+func (p Product) IsSparse() bool {
+	return p.selected != nil
+}
+
+// Has reports whether the field with the given JSON name was returned by the
+// server. Fields left out of a sparse product hold zero values that must not
+// be mistaken for data.
+func (p Product) Has(field string) bool {
+	return !p.IsSparse() || field == "id" || slices.Contains(p.selected, field)
+}
+
+// ProductPatch is a partial update of a product. Only the fields that are
+// set are sent, the others are left untouched on the server. Slices are
+// pointers too so that a field can be cleared with an empty slice. Reviews
+// replace the reviews of the product as a whole.
+type ProductPatch struct {
+	Title                *string             `json:"title,omitempty"`
+	Description          *string             `json:"description,omitempty"`
+	Category             *string             `json:"category,omitempty"`
+	Price                *float64            `json:"price,omitempty"`
+	DiscountPercentage   *float64            `json:"discountPercentage,omitempty"`
+	Rating               *float64            `json:"rating,omitempty"`
+	Stock                *uint               `json:"stock,omitempty"`
+	Tags                 *[]string           `json:"tags,omitempty"`
+	Brand                *string             `json:"brand,omitempty"`
+	Sku                  *string             `json:"sku,omitempty"`
+	Weight               *float64            `json:"weight,omitempty"`
+	Dimensions           *DimensionPatch     `json:"dimensions,omitempty"`
+	WarrantyInfo         *string             `json:"warrantyInformation,omitempty"`
+	ShippingInfo         *string             `json:"shippingInformation,omitempty"`
+	AvailabilityStatus   *AvailabilityStatus `json:"availabilityStatus,omitempty"`
+	Reviews              *[]Review           `json:"reviews,omitempty"`
+	ReturnPolicy         *string             `json:"returnPolicy,omitempty"`
+	MinimumOrderQuantity *uint               `json:"minimumOrderQuantity,omitempty"`
+	Thumbnail            *string             `json:"thumbnail,omitempty"`
+	Images               *[]string           `json:"images,omitempty"`
+}
+
+// Ptr returns a pointer to v, to fill in the fields of a ProductPatch.
+func Ptr[T any](v T) *T {
+	return &v
 }
 
 type Meta struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	CreatedAt Timestamp `json:"createdAt,omitzero"`
+	UpdatedAt Timestamp `json:"updatedAt,omitzero"`
 	Barcode   string    `json:"barcode"`
 	QrCode    string    `json:"qrCode"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (m *Meta) UnmarshalJSON(data []byte) error {
+	type plain Meta
+	return unmarshalWithExtra(data, (*plain)(m), &m.Extra)
+}
+
+func (m Meta) MarshalJSON() ([]byte, error) {
+	type plain Meta
+	return marshalWithExtra(plain(m), m.Extra)
 }
 
 type Review struct {
 	Rating        uint8     `json:"rating"`
 	Comment       string    `json:"comment"`
-	Date          time.Time `json:"date"`
+	Date          Timestamp `json:"date,omitzero"`
 	ReviewerName  string    `json:"reviewerName"`
 	ReviewerEmail string    `json:"reviewerEmail"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Review) UnmarshalJSON(data []byte) error {
+	type plain Review
+	return unmarshalWithExtra(data, (*plain)(r), &r.Extra)
+}
+
+func (r Review) MarshalJSON() ([]byte, error) {
+	type plain Review
+	return marshalWithExtra(plain(r), r.Extra)
 }
 
 type Dimension struct {
 	Width  float64 `json:"width"`
 	Height float64 `json:"height"`
 	Depth  float64 `json:"depth"`
+
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-type DummyClient struct {
-	client *resty.Client
+func (d *Dimension) UnmarshalJSON(data []byte) error {
+	type plain Dimension
+	return unmarshalWithExtra(data, (*plain)(d), &d.Extra)
 }
 
-type DummyError struct {
-	Message string
+func (d Dimension) MarshalJSON() ([]byte, error) {
+	type plain Dimension
+	return marshalWithExtra(plain(d), d.Extra)
 }
 
-func (de DummyError) Error() string {
-	return fmt.Sprintf("error from the server: %v", de.Message)
+// DimensionPatch is a partial update of the dimensions of a product, sent
+// within a ProductPatch. Only the dimensions that are set are sent.
+type DimensionPatch struct {
+	Width  *float64 `json:"width,omitempty"`
+	Height *float64 `json:"height,omitempty"`
+	Depth  *float64 `json:"depth,omitempty"`
 }
 
-func NewDummyClient(url string) *DummyClient {
-	return &DummyClient{client: resty.New().SetBaseURL(url)}
+// GetProducts walks every page of the product listing. The listing is done
+// once the server has returned as many products as its reported total.
+func (dc *DummyClient) GetProducts(ctx context.Context, opts *ListOptions) ([]Product, error) {
+	return listAll[Product, ProductResponse](ctx, dc, "/products", nil, opts)
 }
 
-func (dc *DummyClient) GetProducts() ([]Product, error) {
-	skip, limit := 0, 30
-	var prodRes ProductResponse
-	req := dc.client.R().SetResult(&prodRes)
-	res, err := req.Get("/products")
-	if res.IsError() {
-		return nil, DummyError{Message: string(res.Body())}
-	}
-	if err != nil {
-		return nil, err
-	}
-	var products []Product
-
-	for limit == 30 {
-		res, err = req.SetQueryParams(map[string]string{
-			"limit": strconv.Itoa(limit),
-			"skip":  strconv.Itoa(skip),
-		}).Get("/products")
-		if res.IsError() {
-			return nil, DummyError{Message: string(res.Body())}
-		}
-		if err != nil {
-			return nil, err
-		}
-		skip += int(prodRes.Limit)
-		limit = int(prodRes.Limit)
-		products = append(products, prodRes.Products...)
-	}
-	return products, nil
+// ProductsSeq lazily walks the product listing, fetching the next page only
+// once the caller has ranged over the previous one. Iteration ends after
+// yielding the first error with a zero Product.
+func (dc *DummyClient) ProductsSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Product, error] {
+	return listSeq[Product, ProductResponse](ctx, dc, "/products", nil, opts)
+}
+
+// SearchProducts returns the page of products matching query selected by
+// opts.
+func (dc *DummyClient) SearchProducts(ctx context.Context, query string, opts *ListOptions) (ProductResponse, error) {
+	return getPage[Product, ProductResponse](ctx, dc, "/products/search", map[string]string{"q": query}, opts, opts.skip())
 }
 
-func (dc *DummyClient) GetProduct(id int) (Product, error) {
+// SearchProductsSeq lazily walks every product matching query, like
+// ProductsSeq.
+func (dc *DummyClient) SearchProductsSeq(ctx context.Context, query string, opts *ListOptions) iter.Seq2[Product, error] {
+	return listSeq[Product, ProductResponse](ctx, dc, "/products/search", map[string]string{"q": query}, opts)
+}
+
+func (dc *DummyClient) GetProduct(ctx context.Context, id int) (Product, error) {
 	var product Product
-	res, err := dc.client.R().SetResult(&product).Get(fmt.Sprintf("/products/%d", id))
-	if res.IsError() {
-		return Product{}, DummyError{Message: string(res.Body())}
-	}
-	if err != nil {
+	req := dc.request(ctx).SetResult(&product)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/products/%d", id)); err != nil {
 		return Product{}, err
 	}
 	return product, nil
 }
 
-func (dc *DummyClient) UploadProduct(prod Product) (Product, error) {
-	var created Product
-	res, err := dc.client.R().SetBody(prod).SetResult(&created).Post("/products/add")
-	if res.IsError() {
-		return Product{}, DummyError{Message: string(res.Body())}
+// UploadProduct creates prod, which is validated first unless the client was
+// created with WithoutValidation.
+func (dc *DummyClient) UploadProduct(ctx context.Context, prod Product) (Product, error) {
+	if err := dc.validate(prod); err != nil {
+		return Product{}, err
 	}
-	if err != nil {
+	var created Product
+	req := dc.request(ctx).SetBody(prod).SetResult(&created)
+	if err := dc.execute(req, http.MethodPost, "/products/add"); err != nil {
 		return Product{}, err
 	}
 	return created, nil
 }
 
-func (dc *DummyClient) UpdateProduct(id int, prod Product) (Product, error) {
-	var updated Product
-	res, err := dc.client.R().SetBody(prod).SetResult(&updated).Patch(fmt.Sprintf("/products/%d", id))
-	if res.IsError() {
-		return Product{}, DummyError{Message: string(res.Body())}
+// UpdateProduct changes the fields set in patch and returns the updated
+// product. The patch is validated like in UploadProduct.
+func (dc *DummyClient) UpdateProduct(ctx context.Context, id int, patch ProductPatch) (Product, error) {
+	if err := dc.validate(patch); err != nil {
+		return Product{}, err
 	}
-	if err != nil {
+	var updated Product
+	req := dc.request(ctx).SetBody(patch).SetResult(&updated)
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/products/%d", id)); err != nil {
 		return Product{}, err
 	}
 	return updated, nil
 }
 
-func (dc *DummyClient) DeleteProduct(id int) (Product, error) {
+func (dc *DummyClient) DeleteProduct(ctx context.Context, id int) (Product, error) {
 	var deleted Product
-	res, err := dc.client.R().SetResult(&deleted).Delete(fmt.Sprintf("/products/%d", id))
-	if res.IsError() {
-		return Product{}, DummyError{Message: string(res.Body())}
-	}
-	if err != nil {
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/products/%d", id)); err != nil {
 		return Product{}, err
 	}
 	return deleted, nil
@@ -0,0 +1,95 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// randomQuoteRounds bounds the requests GetRandomQuotes makes to replace
+// duplicate picks.
+const randomQuoteRounds = 5
+
+type QuoteResponse struct {
+	Quotes []Quote `json:"quotes"`
+	Pagination
+}
+
+func (r QuoteResponse) items() []Quote         { return r.Quotes }
+func (r QuoteResponse) pagination() Pagination { return r.Pagination }
+
+type Quote struct {
+	Id     int    `json:"id"`
+	Quote  string `json:"quote"`
+	Author string `json:"author"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (q *Quote) UnmarshalJSON(data []byte) error {
+	type plain Quote
+	return unmarshalWithExtra(data, (*plain)(q), &q.Extra)
+}
+
+func (q Quote) MarshalJSON() ([]byte, error) {
+	type plain Quote
+	return marshalWithExtra(plain(q), q.Extra)
+}
+
+// GetQuotes walks every page of the quote listing, like GetProducts.
+func (dc *DummyClient) GetQuotes(ctx context.Context, opts *ListOptions) ([]Quote, error) {
+	return listAll[Quote, QuoteResponse](ctx, dc, "/quotes", nil, opts)
+}
+
+// QuotesSeq lazily walks the quote listing, like ProductsSeq.
+func (dc *DummyClient) QuotesSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Quote, error] {
+	return listSeq[Quote, QuoteResponse](ctx, dc, "/quotes", nil, opts)
+}
+
+func (dc *DummyClient) GetQuote(ctx context.Context, id int) (Quote, error) {
+	var quote Quote
+	req := dc.request(ctx).SetResult(&quote)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/quotes/%d", id)); err != nil {
+		return Quote{}, err
+	}
+	return quote, nil
+}
+
+// GetRandomQuote returns a quote picked by the server.
+func (dc *DummyClient) GetRandomQuote(ctx context.Context) (Quote, error) {
+	var quote Quote
+	req := dc.request(ctx).SetResult(&quote)
+	if err := dc.execute(req, http.MethodGet, "/quotes/random"); err != nil {
+		return Quote{}, err
+	}
+	return quote, nil
+}
+
+// GetRandomQuotes returns n distinct quotes picked by the server. The server
+// may pick a quote more than once, so duplicates are dropped and more quotes
+// are requested in their place. Fewer than n quotes are returned only if the
+// server keeps picking the same ones.
+func (dc *DummyClient) GetRandomQuotes(ctx context.Context, n int) ([]Quote, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	seen := make(map[int]bool, n)
+	quotes := make([]Quote, 0, n)
+	for round := 0; round < randomQuoteRounds && len(quotes) < n; round++ {
+		var picked []Quote
+		req := dc.request(ctx).SetResult(&picked)
+		if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/quotes/random/%d", n-len(quotes))); err != nil {
+			return nil, err
+		}
+		for _, quote := range picked {
+			if seen[quote.Id] || len(quotes) == n {
+				continue
+			}
+			seen[quote.Id] = true
+			quotes = append(quotes, quote)
+		}
+	}
+	return quotes, nil
+}
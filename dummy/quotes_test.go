@@ -0,0 +1,111 @@
+package dummyjson
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// randomQuotesServer answers the nth request for random quotes with the
+// quotes of picks[n], or of the last picks once they run out, and records
+// the paths requested.
+func randomQuotesServer(picks [][]int, paths *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*paths = append(*paths, r.URL.Path)
+		ids := picks[min(len(*paths), len(picks))-1]
+		quotes := make([]string, len(ids))
+		for i, id := range ids {
+			quotes[i] = fmt.Sprintf(`{"id":%d,"quote":"quote %d","author":"author %d"}`, id, id, id)
+		}
+		writeJSON(w, http.StatusOK, "["+strings.Join(quotes, ",")+"]")
+	}
+}
+
+func quoteIds(quotes []Quote) []int {
+	ids := make([]int, len(quotes))
+	for i, quote := range quotes {
+		ids[i] = quote.Id
+	}
+	return ids
+}
+
+func TestGetRandomQuotes(t *testing.T) {
+	tests := map[string]struct {
+		n         int
+		picks     [][]int
+		wantIds   []int
+		wantPaths []string
+	}{
+		"distinct picks": {
+			n:         3,
+			picks:     [][]int{{4, 8, 15}},
+			wantIds:   []int{4, 8, 15},
+			wantPaths: []string{"/quotes/random/3"},
+		},
+		"duplicates in a pick": {
+			n:         3,
+			picks:     [][]int{{4, 4, 8}, {15}},
+			wantIds:   []int{4, 8, 15},
+			wantPaths: []string{"/quotes/random/3", "/quotes/random/1"},
+		},
+		"duplicates of earlier picks": {
+			n:         4,
+			picks:     [][]int{{4, 8, 15, 15}, {8}, {16}},
+			wantIds:   []int{4, 8, 15, 16},
+			wantPaths: []string{"/quotes/random/4", "/quotes/random/1", "/quotes/random/1"},
+		},
+		"more quotes than asked": {
+			n:         2,
+			picks:     [][]int{{4, 8, 15}},
+			wantIds:   []int{4, 8},
+			wantPaths: []string{"/quotes/random/2"},
+		},
+		// The server keeps picking the same quote, give up after
+		// randomQuoteRounds requests
+		"rounds are capped": {
+			n:         3,
+			picks:     [][]int{{4}},
+			wantIds:   []int{4},
+			wantPaths: []string{"/quotes/random/3", "/quotes/random/2", "/quotes/random/2", "/quotes/random/2", "/quotes/random/2"},
+		},
+		"none":     {n: 0, picks: [][]int{{4}}},
+		"negative": {n: -1, picks: [][]int{{4}}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			dc := newTestClient(t, randomQuotesServer(tt.picks, &paths))
+			quotes, err := dc.GetRandomQuotes(t.Context(), tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := quoteIds(quotes); !slices.Equal(got, tt.wantIds) {
+				t.Errorf("got quotes %v, want %v", got, tt.wantIds)
+			}
+			if !slices.Equal(paths, tt.wantPaths) {
+				t.Errorf("got requests to %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestGetRandomQuotesError(t *testing.T) {
+	var calls int
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls++; calls == 1 {
+			writeJSON(w, http.StatusOK, `[{"id":4},{"id":4}]`)
+			return
+		}
+		writeJSON(w, http.StatusInternalServerError, `{"message":"boom"}`)
+	})
+	quotes, err := dc.GetRandomQuotes(t.Context(), 2)
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("got error %v, want %v", err, ErrServer)
+	}
+	if quotes != nil {
+		t.Errorf("got quotes %v with the error, want none", quoteIds(quotes))
+	}
+}
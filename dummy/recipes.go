@@ -0,0 +1,151 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+type RecipeResponse struct {
+	Recipes []Recipe `json:"recipes"`
+	Pagination
+}
+
+func (r RecipeResponse) items() []Recipe        { return r.Recipes }
+func (r RecipeResponse) pagination() Pagination { return r.Pagination }
+
+type Recipe struct {
+	Id                 int       `json:"id"`
+	Name               string    `json:"name"`
+	Ingredients        []string  `json:"ingredients"`
+	Instructions       []string  `json:"instructions"`
+	PrepTimeMinutes    uint      `json:"prepTimeMinutes"`
+	CookTimeMinutes    uint      `json:"cookTimeMinutes"`
+	Servings           uint      `json:"servings"`
+	Difficulty         string    `json:"difficulty"`
+	Cuisine            string    `json:"cuisine"`
+	CaloriesPerServing uint      `json:"caloriesPerServing"`
+	Tags               []string  `json:"tags"`
+	UserId             int       `json:"userId"`
+	Image              string    `json:"image"`
+	Rating             float64   `json:"rating"`
+	ReviewCount        uint      `json:"reviewCount"`
+	MealType           []string  `json:"mealType"`
+	IsDeleted          bool      `json:"isDeleted,omitempty"`
+	DeletedOn          Timestamp `json:"deletedOn,omitzero"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Recipe) UnmarshalJSON(data []byte) error {
+	type plain Recipe
+	return unmarshalWithExtra(data, (*plain)(r), &r.Extra)
+}
+
+func (r Recipe) MarshalJSON() ([]byte, error) {
+	type plain Recipe
+	return marshalWithExtra(plain(r), r.Extra)
+}
+
+// RecipePatch is a partial update of a recipe, like ProductPatch.
+type RecipePatch struct {
+	Name               *string   `json:"name,omitempty"`
+	Ingredients        *[]string `json:"ingredients,omitempty"`
+	Instructions       *[]string `json:"instructions,omitempty"`
+	PrepTimeMinutes    *uint     `json:"prepTimeMinutes,omitempty"`
+	CookTimeMinutes    *uint     `json:"cookTimeMinutes,omitempty"`
+	Servings           *uint     `json:"servings,omitempty"`
+	Difficulty         *string   `json:"difficulty,omitempty"`
+	Cuisine            *string   `json:"cuisine,omitempty"`
+	CaloriesPerServing *uint     `json:"caloriesPerServing,omitempty"`
+	Tags               *[]string `json:"tags,omitempty"`
+	UserId             *int      `json:"userId,omitempty"`
+	Image              *string   `json:"image,omitempty"`
+	Rating             *float64  `json:"rating,omitempty"`
+	ReviewCount        *uint     `json:"reviewCount,omitempty"`
+	MealType           *[]string `json:"mealType,omitempty"`
+}
+
+// GetRecipes walks every page of the recipe listing, like GetProducts.
+func (dc *DummyClient) GetRecipes(ctx context.Context, opts *ListOptions) ([]Recipe, error) {
+	return listAll[Recipe, RecipeResponse](ctx, dc, "/recipes", nil, opts)
+}
+
+// RecipesSeq lazily walks the recipe listing, like ProductsSeq.
+func (dc *DummyClient) RecipesSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Recipe, error] {
+	return listSeq[Recipe, RecipeResponse](ctx, dc, "/recipes", nil, opts)
+}
+
+// SearchRecipes returns the page of recipes matching query selected by opts.
+func (dc *DummyClient) SearchRecipes(ctx context.Context, query string, opts *ListOptions) (RecipeResponse, error) {
+	return getPage[Recipe, RecipeResponse](ctx, dc, "/recipes/search", map[string]string{"q": query}, opts, opts.skip())
+}
+
+// SearchRecipesSeq lazily walks every recipe matching query, like
+// ProductsSeq.
+func (dc *DummyClient) SearchRecipesSeq(ctx context.Context, query string, opts *ListOptions) iter.Seq2[Recipe, error] {
+	return listSeq[Recipe, RecipeResponse](ctx, dc, "/recipes/search", map[string]string{"q": query}, opts)
+}
+
+// GetRecipeTags returns every tag used by recipes.
+func (dc *DummyClient) GetRecipeTags(ctx context.Context) ([]string, error) {
+	var tags []string
+	req := dc.request(ctx).SetResult(&tags)
+	if err := dc.execute(req, http.MethodGet, "/recipes/tags"); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// GetRecipesByTag returns the page of recipes tagged with tag selected by
+// opts.
+func (dc *DummyClient) GetRecipesByTag(ctx context.Context, tag string, opts *ListOptions) (RecipeResponse, error) {
+	return getPage[Recipe, RecipeResponse](ctx, dc, "/recipes/tag/"+url.PathEscape(tag), nil, opts, opts.skip())
+}
+
+// GetRecipesByMealType returns the page of recipes for the meal type, such as
+// "breakfast", selected by opts.
+func (dc *DummyClient) GetRecipesByMealType(ctx context.Context, mealType string, opts *ListOptions) (RecipeResponse, error) {
+	return getPage[Recipe, RecipeResponse](ctx, dc, "/recipes/meal-type/"+url.PathEscape(mealType), nil, opts, opts.skip())
+}
+
+func (dc *DummyClient) GetRecipe(ctx context.Context, id int) (Recipe, error) {
+	var recipe Recipe
+	req := dc.request(ctx).SetResult(&recipe)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/recipes/%d", id)); err != nil {
+		return Recipe{}, err
+	}
+	return recipe, nil
+}
+
+func (dc *DummyClient) AddRecipe(ctx context.Context, recipe Recipe) (Recipe, error) {
+	var created Recipe
+	req := dc.request(ctx).SetBody(recipe).SetResult(&created)
+	if err := dc.execute(req, http.MethodPost, "/recipes/add"); err != nil {
+		return Recipe{}, err
+	}
+	return created, nil
+}
+
+// UpdateRecipe changes the fields set in patch and returns the updated
+// recipe.
+func (dc *DummyClient) UpdateRecipe(ctx context.Context, id int, patch RecipePatch) (Recipe, error) {
+	var updated Recipe
+	req := dc.request(ctx).SetBody(patch).SetResult(&updated)
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/recipes/%d", id)); err != nil {
+		return Recipe{}, err
+	}
+	return updated, nil
+}
+
+func (dc *DummyClient) DeleteRecipe(ctx context.Context, id int) (Recipe, error) {
+	var deleted Recipe
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/recipes/%d", id)); err != nil {
+		return Recipe{}, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,124 @@
+package dummyjson
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy controls how requests failing with a transport error, a 429 or
+// a 5xx status are retried. Only GET, HEAD and DELETE requests are retried
+// unless RetryPost is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry, doubled on every
+	// following attempt up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// MaxRetryAfter is the longest wait asked by a Retry-After header that is
+	// honoured, the request fails instead of retrying early when the server
+	// asks for more. Zero stands for MaxBackoff.
+	MaxRetryAfter time.Duration
+	// Jitter adds up to 50% of random wait to every backoff so that clients
+	// failing together do not retry together.
+	Jitter bool
+	// RetryPost also retries POST requests such as /products/add. A request
+	// that reached the server before failing may then create duplicates.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy is used by clients created without WithRetry.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:   3,
+	BaseBackoff:   500 * time.Millisecond,
+	MaxBackoff:    10 * time.Second,
+	MaxRetryAfter: time.Minute,
+	Jitter:        true,
+}
+
+// WithRetry replaces DefaultRetryPolicy.
+func WithRetry(policy RetryPolicy) Option {
+	return func(cfg *clientConfig) {
+		cfg.retry = policy
+	}
+}
+
+func (p RetryPolicy) apply(client *resty.Client) {
+	if p.MaxAttempts < 2 {
+		return
+	}
+	client.SetRetryCount(p.MaxAttempts - 1).
+		SetRetryWaitTime(p.BaseBackoff).
+		SetRetryMaxWaitTime(max(p.MaxBackoff, p.maxRetryAfter())).
+		SetRetryAfter(p.backoff).
+		AddRetryCondition(p.shouldRetry)
+}
+
+func (p RetryPolicy) shouldRetry(res *resty.Response, err error) bool {
+	if res == nil || res.Request == nil {
+		return false
+	}
+	switch res.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+	case http.MethodPost:
+		if !p.RetryPost {
+			return false
+		}
+	default:
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return res.StatusCode() == http.StatusTooManyRequests || res.StatusCode() >= http.StatusInternalServerError
+}
+
+// backoff computes the wait before the next attempt, capped at MaxBackoff
+// unless it was asked by Retry-After. A Retry-After longer than MaxRetryAfter
+// ends the retries with an error wrapping the DummyError of the response.
+func (p RetryPolicy) backoff(_ *resty.Client, res *resty.Response) (time.Duration, error) {
+	if wait, ok := retryAfter(res); ok {
+		if wait > p.maxRetryAfter() {
+			return 0, fmt.Errorf("server asked to retry after %s, more than the %s allowed: %w", wait, p.maxRetryAfter(), newDummyError(res))
+		}
+		return wait, nil
+	}
+	wait := p.BaseBackoff
+	for i := 1; i < res.Request.Attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.Jitter && wait > 0 {
+		wait += rand.N(wait/2 + 1)
+	}
+	return min(wait, p.MaxBackoff), nil
+}
+
+func (p RetryPolicy) maxRetryAfter() time.Duration {
+	if p.MaxRetryAfter > 0 {
+		return p.MaxRetryAfter
+	}
+	return p.MaxBackoff
+}
+
+// retryAfter reads the Retry-After header, either in seconds or as a date.
+func retryAfter(res *resty.Response) (time.Duration, bool) {
+	header := res.Header().Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,156 @@
+package dummyjson
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastRetry retries quickly so that the tests do not wait.
+var fastRetry = RetryPolicy{
+	MaxAttempts: 3,
+	BaseBackoff: time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+// failingServer answers the first failures requests with status and the
+// Retry-After header retryAfter when set, then succeeds.
+func failingServer(calls *atomic.Int32, failures int32, status int, retryAfter string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			writeJSON(w, status, `{"message":"try again"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"id":1,"title":"retried"}`)
+	}
+}
+
+func TestRetryStatuses(t *testing.T) {
+	tests := map[string]struct {
+		status    int
+		failures  int32
+		wantCalls int32
+		wantErr   error
+	}{
+		"too many requests": {status: http.StatusTooManyRequests, failures: 1, wantCalls: 2},
+		"unavailable":       {status: http.StatusServiceUnavailable, failures: 2, wantCalls: 3},
+		"out of attempts":   {status: http.StatusServiceUnavailable, failures: 3, wantCalls: 3, wantErr: ErrServer},
+		"not found":         {status: http.StatusNotFound, failures: 1, wantCalls: 1, wantErr: ErrNotFound},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			dc := newTestClient(t, failingServer(&calls, tt.failures, tt.status, ""), WithRetry(fastRetry))
+			_, err := dc.GetProduct(t.Context(), 1)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryPost(t *testing.T) {
+	tests := map[string]struct {
+		retryPost bool
+		wantCalls int32
+	}{
+		"not retried by default": {retryPost: false, wantCalls: 1},
+		"retried when enabled":   {retryPost: true, wantCalls: 2},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			policy := fastRetry
+			policy.RetryPost = tt.retryPost
+			dc := newTestClient(t, failingServer(&calls, 1, http.StatusServiceUnavailable, ""), WithRetry(policy))
+			_, err := dc.UploadProduct(t.Context(), Product{Title: "retried"})
+			if tt.retryPost && err != nil {
+				t.Errorf("got error %v, want none", err)
+			}
+			if !tt.retryPost && !errors.Is(err, ErrServer) {
+				t.Errorf("got error %v, want %v", err, ErrServer)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		maxRetryAfter time.Duration
+		retryAfter    string
+		wantWait      time.Duration
+		wantCalls     int32
+		wantErr       error
+	}{
+		// MaxBackoff is 5ms, the server must still be waited for
+		"longer than max backoff": {maxRetryAfter: 3 * time.Second, retryAfter: "1", wantWait: time.Second, wantCalls: 2},
+		"longer than allowed":     {maxRetryAfter: time.Second, retryAfter: "30", wantCalls: 1, wantErr: ErrRateLimited},
+		"max backoff by default":  {retryAfter: "1", wantCalls: 1, wantErr: ErrRateLimited},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			policy := fastRetry
+			policy.MaxRetryAfter = tt.maxRetryAfter
+			dc := newTestClient(t, failingServer(&calls, 1, http.StatusTooManyRequests, tt.retryAfter), WithRetry(policy))
+			start := time.Now()
+			_, err := dc.GetProduct(t.Context(), 1)
+			elapsed := time.Since(start)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+			if elapsed < tt.wantWait {
+				t.Errorf("retried after %s, want at least %s", elapsed, tt.wantWait)
+			}
+		})
+	}
+}
+
+func TestRetryAfterKeepsResponseError(t *testing.T) {
+	var calls atomic.Int32
+	dc := newTestClient(t, failingServer(&calls, 1, http.StatusTooManyRequests, "30"), WithRetry(fastRetry))
+	_, err := dc.GetProduct(t.Context(), 1)
+	var dummyErr DummyError
+	if !errors.As(err, &dummyErr) {
+		t.Fatalf("got error %v, want a DummyError", err)
+	}
+	if dummyErr.Message != "try again" {
+		t.Errorf("got message %q, want %q", dummyErr.Message, "try again")
+	}
+}
+
+func TestBackoffIsCapped(t *testing.T) {
+	policy := RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusServiceUnavailable, `{}`)
+	})
+	for attempt, want := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		req := dc.request(t.Context())
+		res, _ := req.Get("/products/1")
+		req.Attempt = attempt
+		got, err := policy.backoff(nil, res)
+		if err != nil || got != want {
+			t.Errorf("attempt %d: got %s, %v, want %s", attempt, got, err, want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package dummyjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the layouts accepted for dates, the first one is used
+// to encode them.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	// time.Time.String()
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	time.DateOnly,
+}
+
+// Timestamp is a date sent by DummyJSON. The zero Timestamp is unset: it is
+// decoded from null or an empty string, and left out of the JSON documents
+// of the models. The date is kept in an unexported field rather than
+// embedded, so that the text and binary encodings of time.Time, which know
+// nothing of unset dates, are not promoted.
+type Timestamp struct {
+	t time.Time
+}
+
+// NewTimestamp returns the Timestamp of t, which is unset when t is the zero
+// time.
+func NewTimestamp(t time.Time) Timestamp {
+	if t.IsZero() {
+		return Timestamp{}
+	}
+	return Timestamp{t: t}
+}
+
+// ParseTimestamp parses a date in any of the layouts seen from DummyJSON.
+// The empty string is the unset Timestamp.
+func ParseTimestamp(value string) (Timestamp, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Timestamp{}, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return NewTimestamp(t), nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("unsupported date %q", value)
+}
+
+// Time returns the date of the timestamp, the zero time when it is unset.
+func (ts Timestamp) Time() time.Time {
+	return ts.t
+}
+
+// IsSet reports whether the timestamp holds a date.
+func (ts Timestamp) IsSet() bool {
+	return !ts.t.IsZero()
+}
+
+// String formats the timestamp as RFC 3339, or returns the empty string when
+// it is unset.
+func (ts Timestamp) String() string {
+	if !ts.IsSet() {
+		return ""
+	}
+	return ts.t.Format(timestampLayouts[0])
+}
+
+func (ts Timestamp) MarshalJSON() ([]byte, error) {
+	if !ts.IsSet() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ts.String())
+}
+
+func (ts *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*ts = Timestamp{}
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("decoding date: %w", err)
+	}
+	parsed, err := ParseTimestamp(value)
+	if err != nil {
+		return err
+	}
+	*ts = parsed
+	return nil
+}
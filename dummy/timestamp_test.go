@@ -0,0 +1,114 @@
+package dummyjson
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	utc := time.Date(2024, 5, 23, 8, 56, 21, 618000000, time.UTC)
+	plus2 := time.Date(2024, 5, 23, 10, 56, 21, 618000000, time.FixedZone("", 2*60*60))
+	tests := map[string]struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		"RFC 3339":                     {value: "2024-05-23T08:56:21.618Z", want: utc},
+		"RFC 3339 with offset":         {value: "2024-05-23T10:56:21.618+02:00", want: plus2},
+		"without zone":                 {value: "2024-05-23T08:56:21.618", want: utc},
+		"space separated":              {value: "2024-05-23 10:56:21.618+02:00", want: plus2},
+		"space separated, no zone":     {value: "2024-05-23 08:56:21.618", want: utc},
+		"time.Time.String":             {value: "2024-05-23 08:56:21.618 +0000 UTC", want: utc},
+		"date only":                    {value: "2024-05-23", want: time.Date(2024, 5, 23, 0, 0, 0, 0, time.UTC)},
+		"surrounding spaces":           {value: " 2024-05-23T08:56:21.618Z ", want: utc},
+		"empty":                        {value: ""},
+		"blank":                        {value: "  "},
+		"zero time":                    {value: "0001-01-01T00:00:00Z"},
+		"unsupported":                  {value: "23/05/2024", wantErr: true},
+		"date only with trailing junk": {value: "2024-05-23x", wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseTimestamp(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %t", err, tt.wantErr)
+			}
+			if !got.Time().Equal(tt.want) {
+				t.Errorf("got %s, want %s", got.Time(), tt.want)
+			}
+			if got.IsSet() != !tt.want.IsZero() {
+				t.Errorf("got set: %t, want %t", got.IsSet(), !tt.want.IsZero())
+			}
+		})
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	type model struct {
+		Date Timestamp `json:"date,omitzero"`
+	}
+	tests := map[string]struct {
+		document string
+		wantSet  bool
+		want     string
+	}{
+		"set":     {document: `{"date":"2024-05-23T08:56:21.618Z"}`, wantSet: true, want: `{"date":"2024-05-23T08:56:21.618Z"}`},
+		"empty":   {document: `{"date":""}`, want: `{}`},
+		"null":    {document: `{"date":null}`, want: `{}`},
+		"omitted": {document: `{}`, want: `{}`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var m model
+			if err := json.Unmarshal([]byte(tt.document), &m); err != nil {
+				t.Fatal(err)
+			}
+			if m.Date.IsSet() != tt.wantSet {
+				t.Errorf("got set: %t, want %t", m.Date.IsSet(), tt.wantSet)
+			}
+			encoded, err := json.Marshal(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(encoded) != tt.want {
+				t.Errorf("got %s, want %s", encoded, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimestampUnset(t *testing.T) {
+	var ts Timestamp
+	if encoded, err := json.Marshal(ts); err != nil || string(encoded) != "null" {
+		t.Errorf("got %s, %v, want null", encoded, err)
+	}
+	if ts.String() != "" {
+		t.Errorf("got %q, want the empty string", ts.String())
+	}
+	if NewTimestamp(time.Time{}) != ts {
+		t.Error("the zero time is not unset")
+	}
+	var bad Timestamp
+	if err := json.Unmarshal([]byte(`42`), &bad); err == nil {
+		t.Error("got no error decoding a number")
+	}
+	// Only the JSON encoding knows about unset dates, the text and binary
+	// ones of time.Time must not leak through
+	for name, ok := range map[string]bool{
+		"encoding.TextMarshaler":     implements[encoding.TextMarshaler](ts),
+		"encoding.TextUnmarshaler":   implements[encoding.TextUnmarshaler](&ts),
+		"encoding.BinaryMarshaler":   implements[encoding.BinaryMarshaler](ts),
+		"encoding.BinaryUnmarshaler": implements[encoding.BinaryUnmarshaler](&ts),
+	} {
+		if ok {
+			t.Errorf("Timestamp implements %s", name)
+		}
+	}
+}
+
+func implements[T any](v any) bool {
+	_, ok := v.(T)
+	return ok
+}
@@ -0,0 +1,119 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+type TodoResponse struct {
+	Todos []Todo `json:"todos"`
+	Pagination
+}
+
+func (r TodoResponse) items() []Todo          { return r.Todos }
+func (r TodoResponse) pagination() Pagination { return r.Pagination }
+
+type Todo struct {
+	Id        int       `json:"id"`
+	Todo      string    `json:"todo"`
+	Completed bool      `json:"completed"`
+	UserId    int       `json:"userId"`
+	IsDeleted bool      `json:"isDeleted,omitempty"`
+	DeletedOn Timestamp `json:"deletedOn,omitzero"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Todo) UnmarshalJSON(data []byte) error {
+	type plain Todo
+	return unmarshalWithExtra(data, (*plain)(t), &t.Extra)
+}
+
+func (t Todo) MarshalJSON() ([]byte, error) {
+	type plain Todo
+	return marshalWithExtra(plain(t), t.Extra)
+}
+
+// TodoPatch is a partial update of a todo, like ProductPatch.
+type TodoPatch struct {
+	Todo      *string `json:"todo,omitempty"`
+	Completed *bool   `json:"completed,omitempty"`
+	UserId    *int    `json:"userId,omitempty"`
+}
+
+// GetTodos walks every page of the todo listing, like GetProducts.
+func (dc *DummyClient) GetTodos(ctx context.Context, opts *ListOptions) ([]Todo, error) {
+	return listAll[Todo, TodoResponse](ctx, dc, "/todos", nil, opts)
+}
+
+// TodosSeq lazily walks the todo listing, like ProductsSeq.
+func (dc *DummyClient) TodosSeq(ctx context.Context, opts *ListOptions) iter.Seq2[Todo, error] {
+	return listSeq[Todo, TodoResponse](ctx, dc, "/todos", nil, opts)
+}
+
+// GetTodosByUser returns the page of todos of the user userId selected by
+// opts.
+func (dc *DummyClient) GetTodosByUser(ctx context.Context, userId int, opts *ListOptions) (TodoResponse, error) {
+	return getPage[Todo, TodoResponse](ctx, dc, fmt.Sprintf("/todos/user/%d", userId), nil, opts, opts.skip())
+}
+
+func (dc *DummyClient) GetTodo(ctx context.Context, id int) (Todo, error) {
+	var todo Todo
+	req := dc.request(ctx).SetResult(&todo)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/todos/%d", id)); err != nil {
+		return Todo{}, err
+	}
+	return todo, nil
+}
+
+// GetRandomTodo returns a todo picked by the server.
+func (dc *DummyClient) GetRandomTodo(ctx context.Context) (Todo, error) {
+	var todo Todo
+	req := dc.request(ctx).SetResult(&todo)
+	if err := dc.execute(req, http.MethodGet, "/todos/random"); err != nil {
+		return Todo{}, err
+	}
+	return todo, nil
+}
+
+func (dc *DummyClient) AddTodo(ctx context.Context, todo Todo) (Todo, error) {
+	var created Todo
+	req := dc.request(ctx).SetBody(todo).SetResult(&created)
+	if err := dc.execute(req, http.MethodPost, "/todos/add"); err != nil {
+		return Todo{}, err
+	}
+	return created, nil
+}
+
+// UpdateTodo changes the fields set in patch and returns the updated todo.
+func (dc *DummyClient) UpdateTodo(ctx context.Context, id int, patch TodoPatch) (Todo, error) {
+	var updated Todo
+	req := dc.request(ctx).SetBody(patch).SetResult(&updated)
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/todos/%d", id)); err != nil {
+		return Todo{}, err
+	}
+	return updated, nil
+}
+
+// SetTodoCompleted marks the todo as completed or not, sending only the
+// completed field.
+func (dc *DummyClient) SetTodoCompleted(ctx context.Context, id int, completed bool) (Todo, error) {
+	return dc.UpdateTodo(ctx, id, TodoPatch{Completed: &completed})
+}
+
+// ToggleTodo flips the completion state of todo, see SetTodoCompleted.
+func (dc *DummyClient) ToggleTodo(ctx context.Context, todo Todo) (Todo, error) {
+	return dc.SetTodoCompleted(ctx, todo.Id, !todo.Completed)
+}
+
+func (dc *DummyClient) DeleteTodo(ctx context.Context, id int) (Todo, error) {
+	var deleted Todo
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/todos/%d", id)); err != nil {
+		return Todo{}, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,265 @@
+package dummyjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+type UserResponse struct {
+	Users []User `json:"users"`
+	Pagination
+}
+
+func (r UserResponse) items() []User          { return r.Users }
+func (r UserResponse) pagination() Pagination { return r.Pagination }
+
+type User struct {
+	Id         int       `json:"id"`
+	FirstName  string    `json:"firstName"`
+	LastName   string    `json:"lastName"`
+	MaidenName string    `json:"maidenName"`
+	Age        uint      `json:"age"`
+	Gender     string    `json:"gender"`
+	Email      string    `json:"email"`
+	Phone      string    `json:"phone"`
+	Username   string    `json:"username"`
+	Password   string    `json:"password"`
+	BirthDate  string    `json:"birthDate"`
+	Image      string    `json:"image"`
+	BloodGroup string    `json:"bloodGroup"`
+	Height     float64   `json:"height"`
+	Weight     float64   `json:"weight"`
+	EyeColor   string    `json:"eyeColor"`
+	Hair       Hair      `json:"hair"`
+	Ip         string    `json:"ip"`
+	Address    Address   `json:"address"`
+	MacAddress string    `json:"macAddress"`
+	University string    `json:"university"`
+	Bank       Bank      `json:"bank"`
+	Company    Company   `json:"company"`
+	Ein        string    `json:"ein"`
+	Ssn        string    `json:"ssn"`
+	UserAgent  string    `json:"userAgent"`
+	Crypto     Crypto    `json:"crypto"`
+	Role       string    `json:"role"`
+	IsDeleted  bool      `json:"isDeleted,omitempty"`
+	DeletedOn  Timestamp `json:"deletedOn,omitzero"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type plain User
+	return unmarshalWithExtra(data, (*plain)(u), &u.Extra)
+}
+
+func (u User) MarshalJSON() ([]byte, error) {
+	type plain User
+	return marshalWithExtra(plain(u), u.Extra)
+}
+
+// UserPatch is a partial update of a user, like ProductPatch. Nested objects
+// are replaced as a whole when set.
+type UserPatch struct {
+	FirstName  *string  `json:"firstName,omitempty"`
+	LastName   *string  `json:"lastName,omitempty"`
+	MaidenName *string  `json:"maidenName,omitempty"`
+	Age        *uint    `json:"age,omitempty"`
+	Gender     *string  `json:"gender,omitempty"`
+	Email      *string  `json:"email,omitempty"`
+	Phone      *string  `json:"phone,omitempty"`
+	Username   *string  `json:"username,omitempty"`
+	Password   *string  `json:"password,omitempty"`
+	BirthDate  *string  `json:"birthDate,omitempty"`
+	Image      *string  `json:"image,omitempty"`
+	BloodGroup *string  `json:"bloodGroup,omitempty"`
+	Height     *float64 `json:"height,omitempty"`
+	Weight     *float64 `json:"weight,omitempty"`
+	EyeColor   *string  `json:"eyeColor,omitempty"`
+	Hair       *Hair    `json:"hair,omitempty"`
+	Ip         *string  `json:"ip,omitempty"`
+	Address    *Address `json:"address,omitempty"`
+	MacAddress *string  `json:"macAddress,omitempty"`
+	University *string  `json:"university,omitempty"`
+	Bank       *Bank    `json:"bank,omitempty"`
+	Company    *Company `json:"company,omitempty"`
+	Ein        *string  `json:"ein,omitempty"`
+	Ssn        *string  `json:"ssn,omitempty"`
+	UserAgent  *string  `json:"userAgent,omitempty"`
+	Crypto     *Crypto  `json:"crypto,omitempty"`
+	Role       *string  `json:"role,omitempty"`
+}
+
+type Hair struct {
+	Color string `json:"color"`
+	Type  string `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (h *Hair) UnmarshalJSON(data []byte) error {
+	type plain Hair
+	return unmarshalWithExtra(data, (*plain)(h), &h.Extra)
+}
+
+func (h Hair) MarshalJSON() ([]byte, error) {
+	type plain Hair
+	return marshalWithExtra(plain(h), h.Extra)
+}
+
+type Address struct {
+	Address     string      `json:"address"`
+	City        string      `json:"city"`
+	State       string      `json:"state"`
+	StateCode   string      `json:"stateCode"`
+	PostalCode  string      `json:"postalCode"`
+	Coordinates Coordinates `json:"coordinates"`
+	Country     string      `json:"country"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Address) UnmarshalJSON(data []byte) error {
+	type plain Address
+	return unmarshalWithExtra(data, (*plain)(a), &a.Extra)
+}
+
+func (a Address) MarshalJSON() ([]byte, error) {
+	type plain Address
+	return marshalWithExtra(plain(a), a.Extra)
+}
+
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Coordinates) UnmarshalJSON(data []byte) error {
+	type plain Coordinates
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c Coordinates) MarshalJSON() ([]byte, error) {
+	type plain Coordinates
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+type Bank struct {
+	CardExpire string `json:"cardExpire"`
+	CardNumber string `json:"cardNumber"`
+	CardType   string `json:"cardType"`
+	Currency   string `json:"currency"`
+	Iban       string `json:"iban"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (b *Bank) UnmarshalJSON(data []byte) error {
+	type plain Bank
+	return unmarshalWithExtra(data, (*plain)(b), &b.Extra)
+}
+
+func (b Bank) MarshalJSON() ([]byte, error) {
+	type plain Bank
+	return marshalWithExtra(plain(b), b.Extra)
+}
+
+type Company struct {
+	Department string  `json:"department"`
+	Name       string  `json:"name"`
+	Title      string  `json:"title"`
+	Address    Address `json:"address"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Company) UnmarshalJSON(data []byte) error {
+	type plain Company
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c Company) MarshalJSON() ([]byte, error) {
+	type plain Company
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+type Crypto struct {
+	Coin    string `json:"coin"`
+	Wallet  string `json:"wallet"`
+	Network string `json:"network"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Crypto) UnmarshalJSON(data []byte) error {
+	type plain Crypto
+	return unmarshalWithExtra(data, (*plain)(c), &c.Extra)
+}
+
+func (c Crypto) MarshalJSON() ([]byte, error) {
+	type plain Crypto
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// GetUsers walks every page of the user listing, like GetProducts.
+func (dc *DummyClient) GetUsers(ctx context.Context, opts *ListOptions) ([]User, error) {
+	return listAll[User, UserResponse](ctx, dc, "/users", nil, opts)
+}
+
+// UsersSeq lazily walks the user listing, like ProductsSeq.
+func (dc *DummyClient) UsersSeq(ctx context.Context, opts *ListOptions) iter.Seq2[User, error] {
+	return listSeq[User, UserResponse](ctx, dc, "/users", nil, opts)
+}
+
+// SearchUsers returns the page of users matching query selected by opts.
+func (dc *DummyClient) SearchUsers(ctx context.Context, query string, opts *ListOptions) (UserResponse, error) {
+	return getPage[User, UserResponse](ctx, dc, "/users/search", map[string]string{"q": query}, opts, opts.skip())
+}
+
+// FilterUsers returns the page of users whose field key equals value. Nested
+// fields are separated by dots, such as "hair.color".
+func (dc *DummyClient) FilterUsers(ctx context.Context, key, value string, opts *ListOptions) (UserResponse, error) {
+	return getPage[User, UserResponse](ctx, dc, "/users/filter", map[string]string{"key": key, "value": value}, opts, opts.skip())
+}
+
+func (dc *DummyClient) GetUser(ctx context.Context, id int) (User, error) {
+	var user User
+	req := dc.request(ctx).SetResult(&user)
+	if err := dc.execute(req, http.MethodGet, fmt.Sprintf("/users/%d", id)); err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+func (dc *DummyClient) AddUser(ctx context.Context, user User) (User, error) {
+	var created User
+	req := dc.request(ctx).SetBody(user).SetResult(&created)
+	if err := dc.execute(req, http.MethodPost, "/users/add"); err != nil {
+		return User{}, err
+	}
+	return created, nil
+}
+
+// UpdateUser changes the fields set in patch and returns the updated user.
+func (dc *DummyClient) UpdateUser(ctx context.Context, id int, patch UserPatch) (User, error) {
+	var updated User
+	req := dc.request(ctx).SetBody(patch).SetResult(&updated)
+	if err := dc.execute(req, http.MethodPatch, fmt.Sprintf("/users/%d", id)); err != nil {
+		return User{}, err
+	}
+	return updated, nil
+}
+
+func (dc *DummyClient) DeleteUser(ctx context.Context, id int) (User, error) {
+	var deleted User
+	req := dc.request(ctx).SetResult(&deleted)
+	if err := dc.execute(req, http.MethodDelete, fmt.Sprintf("/users/%d", id)); err != nil {
+		return User{}, err
+	}
+	return deleted, nil
+}
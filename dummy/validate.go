@@ -0,0 +1,185 @@
+package dummyjson
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalid is matched by errors.Is against a ValidationError.
+var ErrInvalid = errors.New("invalid")
+
+// FieldError is a violation of the field at Path, written with the JSON
+// names of the fields such as "reviews[0].reviewerEmail".
+type FieldError struct {
+	Path    string
+	Message string
+}
+
+func (fe FieldError) Error() string {
+	return fe.Path + ": " + fe.Message
+}
+
+// ValidationError lists every violation found by a Validate method.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (ve ValidationError) Error() string {
+	messages := make([]string, len(ve.Fields))
+	for i, field := range ve.Fields {
+		messages[i] = field.Error()
+	}
+	return "invalid product: " + strings.Join(messages, "; ")
+}
+
+func (ve ValidationError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+func (ve ValidationError) Unwrap() []error {
+	errs := make([]error, len(ve.Fields))
+	for i, field := range ve.Fields {
+		errs[i] = field
+	}
+	return errs
+}
+
+// Validate checks the product before it is sent to the server, returning a
+// ValidationError listing every violation.
+func (p Product) Validate() error {
+	var v validator
+	if strings.TrimSpace(p.Title) == "" {
+		v.add("title", "is required")
+	}
+	p.validateFields(&v)
+	return v.err()
+}
+
+// Validate checks the fields set in the patch like Product.Validate.
+func (pp ProductPatch) Validate() error {
+	var v validator
+	if pp.Title != nil && strings.TrimSpace(*pp.Title) == "" {
+		v.add("title", "must not be empty")
+	}
+	// Unset fields are zero, which is always valid
+	dimensions := deref(pp.Dimensions)
+	product := Product{
+		Price:              deref(pp.Price),
+		DiscountPercentage: deref(pp.DiscountPercentage),
+		Rating:             deref(pp.Rating),
+		Weight:             deref(pp.Weight),
+		Dimensions: Dimension{
+			Width:  deref(dimensions.Width),
+			Height: deref(dimensions.Height),
+			Depth:  deref(dimensions.Depth),
+		},
+		AvailabilityStatus: deref(pp.AvailabilityStatus),
+		Reviews:            deref(pp.Reviews),
+		Thumbnail:          deref(pp.Thumbnail),
+		Images:             deref(pp.Images),
+	}
+	product.validateFields(&v)
+	return v.err()
+}
+
+// validateFields checks the fields shared by Product and ProductPatch.
+func (p Product) validateFields(v *validator) {
+	v.between("price", p.Price, 0, math.Inf(1))
+	v.between("discountPercentage", p.DiscountPercentage, 0, 100)
+	v.between("rating", p.Rating, 0, 5)
+	v.between("weight", p.Weight, 0, math.Inf(1))
+	v.between("dimensions.width", p.Dimensions.Width, 0, math.Inf(1))
+	v.between("dimensions.height", p.Dimensions.Height, 0, math.Inf(1))
+	v.between("dimensions.depth", p.Dimensions.Depth, 0, math.Inf(1))
+	if p.AvailabilityStatus != "" && !p.AvailabilityStatus.IsValid() {
+		v.add("availabilityStatus", fmt.Sprintf("must be one of %q, got %q", AvailabilityStatuses, p.AvailabilityStatus))
+	}
+	v.url("thumbnail", p.Thumbnail)
+	for i, image := range p.Images {
+		v.url(fmt.Sprintf("images[%d]", i), image)
+	}
+	for i, review := range p.Reviews {
+		path := fmt.Sprintf("reviews[%d]", i)
+		if review.Rating > 5 {
+			v.add(path+".rating", fmt.Sprintf("must be between 0 and 5, got %d", review.Rating))
+		}
+		v.email(path+".reviewerEmail", review.ReviewerEmail)
+	}
+}
+
+// validate runs the Validate method of model unless validation is disabled,
+// then its CheckAvailability method when the client was created with
+// WithAvailabilityCheck.
+func (dc *DummyClient) validate(model interface {
+	Validate() error
+	CheckAvailability(StockThresholds) error
+}) error {
+	if dc.skipValidation {
+		return nil
+	}
+	if err := model.Validate(); err != nil || dc.thresholds == nil {
+		return err
+	}
+	return model.CheckAvailability(*dc.thresholds)
+}
+
+// validator collects the violations found while validating a model.
+type validator struct {
+	fields []FieldError
+}
+
+func (v *validator) add(path, message string) {
+	v.fields = append(v.fields, FieldError{Path: path, Message: message})
+}
+
+func (v *validator) between(path string, value, low, high float64) {
+	switch {
+	case math.IsNaN(value):
+		v.add(path, "must be a number")
+	case math.IsInf(high, 1) && value < low:
+		v.add(path, fmt.Sprintf("must not be negative, got %g", value))
+	case value < low || value > high:
+		v.add(path, fmt.Sprintf("must be between %g and %g, got %g", low, high, value))
+	}
+}
+
+// url checks that a non-empty value is an absolute HTTP(S) URL.
+func (v *validator) url(path, value string) {
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(path, fmt.Sprintf("must be an HTTP(S) URL, got %q", value))
+	}
+}
+
+// email checks that a non-empty value is a bare email address.
+func (v *validator) email(path, value string) {
+	if value == "" {
+		return
+	}
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value {
+		v.add(path, fmt.Sprintf("must be an email address, got %q", value))
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return ValidationError{Fields: v.fields}
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
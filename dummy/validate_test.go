@@ -0,0 +1,175 @@
+package dummyjson
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// paths returns the paths of the fields reported by err, nil when it is not
+// a ValidationError.
+func paths(err error) []string {
+	var invalid ValidationError
+	if !errors.As(err, &invalid) {
+		return nil
+	}
+	paths := make([]string, len(invalid.Fields))
+	for i, field := range invalid.Fields {
+		paths[i] = field.Path
+	}
+	return paths
+}
+
+func TestProductValidate(t *testing.T) {
+	valid := func() Product {
+		return Product{
+			Title:              "Mascara",
+			Price:              9.99,
+			DiscountPercentage: 7.5,
+			Rating:             4.9,
+			AvailabilityStatus: InStock,
+			Thumbnail:          "https://cdn.dummyjson.com/thumbnail.png",
+			Images:             []string{"https://cdn.dummyjson.com/1.png"},
+			Reviews:            []Review{{Rating: 5, ReviewerEmail: "john@x.dummyjson.com"}},
+		}
+	}
+	tests := map[string]struct {
+		edit func(p *Product)
+		want []string
+	}{
+		"valid":   {edit: func(p *Product) {}},
+		"minimal": {edit: func(p *Product) { *p = Product{Title: "Mascara"} }},
+		"missing title": {
+			edit: func(p *Product) { p.Title = " " },
+			want: []string{"title"},
+		},
+		"negative price": {
+			edit: func(p *Product) { p.Price = -1 },
+			want: []string{"price"},
+		},
+		"not a number": {
+			edit: func(p *Product) { p.Weight = math.NaN() },
+			want: []string{"weight"},
+		},
+		"discount over 100": {
+			edit: func(p *Product) { p.DiscountPercentage = 100.5 },
+			want: []string{"discountPercentage"},
+		},
+		"rating over 5": {
+			edit: func(p *Product) { p.Rating = 5.1 },
+			want: []string{"rating"},
+		},
+		"negative dimension": {
+			edit: func(p *Product) { p.Dimensions.Depth = -0.1 },
+			want: []string{"dimensions.depth"},
+		},
+		"unknown availability status": {
+			edit: func(p *Product) { p.AvailabilityStatus = "Sold out" },
+			want: []string{"availabilityStatus"},
+		},
+		"relative thumbnail": {
+			edit: func(p *Product) { p.Thumbnail = "/thumbnail.png" },
+			want: []string{"thumbnail"},
+		},
+		"image without host": {
+			edit: func(p *Product) { p.Images = append(p.Images, "https://") },
+			want: []string{"images[1]"},
+		},
+		"review rating over 5": {
+			edit: func(p *Product) { p.Reviews[0].Rating = 6 },
+			want: []string{"reviews[0].rating"},
+		},
+		"reviewer email with a name": {
+			edit: func(p *Product) { p.Reviews[0].ReviewerEmail = "John <john@x.dummyjson.com>" },
+			want: []string{"reviews[0].reviewerEmail"},
+		},
+		"every violation": {
+			edit: func(p *Product) { p.Title, p.Price, p.Thumbnail = "", -1, "ftp://x" },
+			want: []string{"title", "price", "thumbnail"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			product := valid()
+			tt.edit(&product)
+			err := product.Validate()
+			if got := paths(err); !slices.Equal(got, tt.want) {
+				t.Errorf("got violations %v, want %v (error %v)", got, tt.want, err)
+			}
+			if tt.want != nil && !errors.Is(err, ErrInvalid) {
+				t.Errorf("got error %v, want %v", err, ErrInvalid)
+			}
+		})
+	}
+}
+
+func TestProductPatchValidate(t *testing.T) {
+	tests := map[string]struct {
+		patch ProductPatch
+		want  []string
+	}{
+		"empty":             {},
+		"zero values":       {patch: ProductPatch{Price: Ptr(0.0), Stock: Ptr(uint(0)), Images: &[]string{}}},
+		"title":             {patch: ProductPatch{Title: Ptr("Mascara")}},
+		"empty title":       {patch: ProductPatch{Title: Ptr("")}, want: []string{"title"}},
+		"negative price":    {patch: ProductPatch{Price: Ptr(-1.0)}, want: []string{"price"}},
+		"rating over 5":     {patch: ProductPatch{Rating: Ptr(7.0)}, want: []string{"rating"}},
+		"unknown status":    {patch: ProductPatch{AvailabilityStatus: Ptr(AvailabilityStatus("Sold out"))}, want: []string{"availabilityStatus"}},
+		"invalid image":     {patch: ProductPatch{Images: &[]string{"not a url"}}, want: []string{"images[0]"}},
+		"invalid review":    {patch: ProductPatch{Reviews: &[]Review{{Rating: 9}}}, want: []string{"reviews[0].rating"}},
+		"invalid dimension": {patch: ProductPatch{Dimensions: &DimensionPatch{Width: Ptr(-1.0)}}, want: []string{"dimensions.width"}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := paths(tt.patch.Validate()); !slices.Equal(got, tt.want) {
+				t.Errorf("got violations %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	err := error(ValidationError{Fields: []FieldError{
+		{Path: "title", Message: "is required"},
+		{Path: "price", Message: "must not be negative, got -1"},
+	}})
+	if want := "invalid product: title: is required; price: must not be negative, got -1"; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	var field FieldError
+	if !errors.As(err, &field) || field.Path != "title" {
+		t.Errorf("got field %+v, want the title", field)
+	}
+}
+
+func TestValidationBeforeSending(t *testing.T) {
+	tests := map[string]struct {
+		opts      []Option
+		wantErr   error
+		wantCalls int
+	}{
+		"validated":          {wantErr: ErrInvalid},
+		"without validation": {opts: []Option{WithoutValidation()}, wantCalls: 2},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			dc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				writeJSON(w, http.StatusOK, `{"id":1}`)
+			}, tt.opts...)
+			_, errUpload := dc.UploadProduct(t.Context(), Product{Price: -1})
+			_, errUpdate := dc.UpdateProduct(t.Context(), 1, ProductPatch{Price: Ptr(-1.0)})
+			for _, err := range []error{errUpload, errUpdate} {
+				if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
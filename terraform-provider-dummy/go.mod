@@ -1,11 +1,12 @@
 module demo.null/terraform-provider-dummy
 
-go 1.22.3
+go 1.24.0
 
 require (
 	demo.null/dummy v0.0.0-00010101000000-000000000000
 	github.com/hashicorp/terraform-plugin-docs v0.19.4
 	github.com/hashicorp/terraform-plugin-framework v1.9.0
+	github.com/hashicorp/terraform-plugin-framework-validators v0.12.0
 	github.com/hashicorp/terraform-plugin-go v0.23.0
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 	github.com/hashicorp/terraform-plugin-testing v1.8.0
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dummyjson "demo.null/dummy"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = availabilityStatusValidator{}
+
+// availabilityStatusValidator checks that a string attribute holds one of
+// the availability statuses known to DummyJSON. The match is exact since the
+// server answers with the canonical spelling.
+type availabilityStatusValidator struct{}
+
+func (v availabilityStatusValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", availabilityStatusList())
+}
+
+func (v availabilityStatusValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v availabilityStatusValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if dummyjson.AvailabilityStatus(value).IsValid() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid availability status",
+		fmt.Sprintf("Availability status must be one of %s, got: %q", availabilityStatusList(), value),
+	)
+}
+
+// availabilityStatusList quotes the known availability statuses.
+func availabilityStatusList() string {
+	quoted := make([]string, len(dummyjson.AvailabilityStatuses))
+	for i, status := range dummyjson.AvailabilityStatuses {
+		quoted[i] = fmt.Sprintf("%q", status)
+	}
+	return strings.Join(quoted, ", ")
+}
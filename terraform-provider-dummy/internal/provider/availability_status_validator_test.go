@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAvailabilityStatusValidator(t *testing.T) {
+	tests := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"in stock":     {value: types.StringValue("In Stock")},
+		"low stock":    {value: types.StringValue("Low Stock")},
+		"out of stock": {value: types.StringValue("Out of Stock")},
+		"null":         {value: types.StringNull()},
+		"unknown":      {value: types.StringUnknown()},
+		"wrong case":   {value: types.StringValue("in stock"), wantErr: true},
+		"unknown name": {value: types.StringValue("Sold out"), wantErr: true},
+		"empty":        {value: types.StringValue(""), wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("availability_status"), ConfigValue: tt.value}
+			var resp validator.StringResponse
+			availabilityStatusValidator{}.ValidateString(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+// testProductConfig returns a configuration of the product resource where
+// the attributes of values are set and the others are null.
+func testProductConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	productSchema := testProductResourceSchema()
+	objectType := productSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("unknown attribute %s", name)
+		}
+		attributes[name] = value
+	}
+	return tfsdk.Config{Schema: productSchema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestProductResourceValidateConfig(t *testing.T) {
+	stock := func(v int64) tftypes.Value { return tftypes.NewValue(tftypes.Number, v) }
+	status := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"neither":        {},
+		"only stock":     {values: map[string]tftypes.Value{"stock": stock(0)}},
+		"only status":    {values: map[string]tftypes.Value{"availability_status": status("In Stock")}},
+		"in stock":       {values: map[string]tftypes.Value{"stock": stock(10), "availability_status": status("In Stock")}},
+		"low stock":      {values: map[string]tftypes.Value{"stock": stock(9), "availability_status": status("Low Stock")}},
+		"out of stock":   {values: map[string]tftypes.Value{"stock": stock(0), "availability_status": status("Out of Stock")}},
+		"unknown stock":  {values: map[string]tftypes.Value{"stock": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), "availability_status": status("In Stock")}},
+		"invalid status": {values: map[string]tftypes.Value{"stock": stock(0), "availability_status": status("Sold out")}},
+		"negative stock": {values: map[string]tftypes.Value{"stock": stock(-1), "availability_status": status("In Stock")}},
+		"contradiction": {
+			values:  map[string]tftypes.Value{"stock": stock(0), "availability_status": status("In Stock")},
+			wantErr: true,
+		},
+		"low stock at the threshold": {
+			values:  map[string]tftypes.Value{"stock": stock(10), "availability_status": status("Low Stock")},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := fwresource.ValidateConfigRequest{Config: testProductConfig(t, tt.values)}
+			var resp fwresource.ValidateConfigResponse
+			(&ProductResource{}).ValidateConfig(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,7 +3,13 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
+
+	dummyjson "demo.null/dummy"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -84,3 +90,57 @@ var DimensionModelType = map[string]attr.Type{
 	"height": types.Float64Type,
 	"depth":  types.Float64Type,
 }
+
+// timestampValue renders a DummyJSON date as RFC 3339, unset dates are null.
+func timestampValue(ts dummyjson.Timestamp) types.String {
+	if !ts.IsSet() {
+		return types.StringNull()
+	}
+	return types.StringValue(ts.String())
+}
+
+// int64InRange returns the value of an integer attribute, reporting it
+// instead when it is outside [low, high], so that it is never converted to a
+// narrower or unsigned type out of range. Values unknown during the plan are
+// not covered by the schema validators and are only checked here.
+func int64InRange(diags *diag.Diagnostics, p path.Path, value types.Int64, low, high int64) int64 {
+	v := value.ValueInt64()
+	if v < low || v > high {
+		diags.AddAttributeError(p, "Value out of range", fmt.Sprintf("Attribute %s must be between %d and %d, got: %d", p, low, high, v))
+		return 0
+	}
+	return v
+}
+
+// addClientError reports an error returned by the DummyJSON client, adding
+// the HTTP details and a hint when the server answered with an error status,
+// or the list of violations when the product failed validation.
+func addClientError(diags *diag.Diagnostics, summary string, action string, err error) {
+	var invalidErr dummyjson.ValidationError
+	if errors.As(err, &invalidErr) {
+		detail := action + ", the product is invalid:"
+		for _, field := range invalidErr.Fields {
+			detail += "\n  - " + field.Error()
+		}
+		diags.AddError(summary, detail)
+		return
+	}
+	var dummyErr dummyjson.DummyError
+	if !errors.As(err, &dummyErr) {
+		diags.AddError(summary, fmt.Sprintf("%s, got error: %s", action, err))
+		return
+	}
+	detail := fmt.Sprintf("%s, DummyJSON responded with HTTP %d to %s %s", action, dummyErr.StatusCode, dummyErr.Method, dummyErr.Path)
+	if dummyErr.Message != "" {
+		detail += ": " + dummyErr.Message
+	}
+	switch {
+	case errors.Is(err, dummyjson.ErrUnauthorized), errors.Is(err, dummyjson.ErrForbidden):
+		detail += "\n\nPlease check the username, password or access token configured for the provider."
+	case errors.Is(err, dummyjson.ErrRateLimited):
+		detail += "\n\nDummyJSON is rate limiting requests, please try again later."
+	case errors.Is(err, dummyjson.ErrServer):
+		detail += "\n\nThis is likely a temporary problem with DummyJSON, please try again later."
+	}
+	diags.AddError(summary, detail)
+}
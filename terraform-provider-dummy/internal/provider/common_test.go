@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+
+	dummyjson "demo.null/dummy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAddClientError(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want string
+	}{
+		"invalid product": {
+			err: dummyjson.ValidationError{Fields: []dummyjson.FieldError{
+				{Path: "title", Message: "is required"},
+				{Path: "reviews[0].rating", Message: "must be between 0 and 5, got 6"},
+			}},
+			want: "Unable to create product, the product is invalid:\n  - title: is required\n  - reviews[0].rating: must be between 0 and 5, got 6",
+		},
+		"wrapped invalid product": {
+			err:  fmt.Errorf("uploading: %w", dummyjson.ValidationError{Fields: []dummyjson.FieldError{{Path: "price", Message: "must not be negative, got -1"}}}),
+			want: "Unable to create product, the product is invalid:\n  - price: must not be negative, got -1",
+		},
+		"not found": {
+			err:  dummyjson.DummyError{StatusCode: http.StatusNotFound, Method: http.MethodPost, Path: "/products/add", Message: "Product not found"},
+			want: "Unable to create product, DummyJSON responded with HTTP 404 to POST /products/add: Product not found",
+		},
+		"unauthorized": {
+			err:  dummyjson.DummyError{StatusCode: http.StatusUnauthorized, Method: http.MethodPost, Path: "/products/add"},
+			want: "Unable to create product, DummyJSON responded with HTTP 401 to POST /products/add\n\nPlease check the username, password or access token configured for the provider.",
+		},
+		"rate limited": {
+			err:  dummyjson.DummyError{StatusCode: http.StatusTooManyRequests, Method: http.MethodPost, Path: "/products/add"},
+			want: "Unable to create product, DummyJSON responded with HTTP 429 to POST /products/add\n\nDummyJSON is rate limiting requests, please try again later.",
+		},
+		"server error": {
+			err:  dummyjson.DummyError{StatusCode: http.StatusBadGateway, Method: http.MethodPost, Path: "/products/add"},
+			want: "Unable to create product, DummyJSON responded with HTTP 502 to POST /products/add\n\nThis is likely a temporary problem with DummyJSON, please try again later.",
+		},
+		"other error": {
+			err:  errors.New("connection refused"),
+			want: "Unable to create product, got error: connection refused",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addClientError(&diags, "Client Error", "Unable to create product", tt.err)
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1", len(diags))
+			}
+			if got := diags[0].Summary(); got != "Client Error" {
+				t.Errorf("got summary %q, want %q", got, "Client Error")
+			}
+			if got := diags[0].Detail(); got != tt.want {
+				t.Errorf("got detail %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt64InRange(t *testing.T) {
+	tests := map[string]struct {
+		value     types.Int64
+		low, high int64
+		want      int64
+		wantErr   bool
+	}{
+		"in range":       {value: types.Int64Value(3), high: 5, want: 3},
+		"bounds":         {value: types.Int64Value(5), high: 5, want: 5},
+		"null":           {value: types.Int64Null(), high: 5},
+		"negative":       {value: types.Int64Value(-1), high: math.MaxInt64, wantErr: true},
+		"above":          {value: types.Int64Value(261), high: 5, wantErr: true},
+		"below the low":  {value: types.Int64Value(1), low: 2, high: 5, wantErr: true},
+		"largest values": {value: types.Int64Value(math.MaxInt64), high: math.MaxInt64, want: math.MaxInt64},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := int64InRange(&diags, path.Root("stock"), tt.value, tt.low, tt.high)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", diags, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
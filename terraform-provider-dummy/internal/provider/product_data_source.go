@@ -175,7 +175,7 @@ func (d *ProductDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 						"date": schema.StringAttribute{
 							Optional:            true,
 							Computed:            true,
-							MarkdownDescription: "Review date of this user",
+							MarkdownDescription: "Review date of this user, in RFC 3339 format",
 						},
 						"reviewer_name": schema.StringAttribute{
 							Optional:            true,
@@ -252,9 +252,9 @@ func (d *ProductDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	product, err := d.client.GetProduct(int(data.Id.ValueInt64()))
+	product, err := d.client.GetProduct(ctx, int(data.Id.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("DummyClient Error", fmt.Sprintf("Unable to get products from DummyJSON , got error: %s", err))
+		addClientError(&resp.Diagnostics, "DummyClient Error", "Unable to get product from DummyJSON", err)
 		return
 	}
 	// Update current data with the ones from DummyJSON
@@ -282,7 +282,7 @@ func (d *ProductDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	resp.Diagnostics.Append(diags...)
 	data.WarrantyInfo = types.StringValue(product.WarrantyInfo)
 	data.ShippingInfo = types.StringValue(product.ShippingInfo)
-	data.AvailabilityStatus = types.StringValue(product.AvailabilityStatus)
+	data.AvailabilityStatus = types.StringValue(string(product.AvailabilityStatus))
 	data.ReturnPolicy = types.StringValue(product.ReturnPolicy)
 	data.MinimumOrderQuantity = types.Int64Value(int64(product.MinimumOrderQuantity))
 	data.Thumbnail = types.StringValue(product.Thumbnail)
@@ -293,7 +293,7 @@ func (d *ProductDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		rm := ReviewModel{
 			Rating:        types.Int64Value(int64(review.Rating)),
 			Comment:       types.StringValue(review.Comment),
-			Date:          types.StringValue(review.Date.String()),
+			Date:          timestampValue(review.Date),
 			ReviewerName:  types.StringValue(review.ReviewerName),
 			ReviewerEmail: types.StringValue(review.ReviewerEmail),
 		}
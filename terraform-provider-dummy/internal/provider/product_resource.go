@@ -5,9 +5,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"regexp"
 
 	dummyjson "demo.null/dummy"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,6 +32,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProductResource{}
 var _ resource.ResourceWithImportState = &ProductResource{}
+var _ resource.ResourceWithValidateConfig = &ProductResource{}
 
 func NewProductResource() resource.Resource {
 	return &ProductResource{}
@@ -78,11 +86,10 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"title": schema.StringAttribute{
-				Optional:            true,
-				Computed:            true,
-				MarkdownDescription: "The title of the product",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+				Required:            true,
+				MarkdownDescription: "The title of the product, which must not be blank",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`\S`), "must not be blank"),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -132,6 +139,9 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 			"tags": schema.ListAttribute{
 				Optional:            true,
@@ -216,10 +226,13 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"availability_status": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The availability status of the product",
+				MarkdownDescription: "The availability status of the product, one of `In Stock`, `Low Stock` or `Out of Stock`. When `stock` is set too, it must match it: `Out of Stock` at 0, `Low Stock` below 10",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					availabilityStatusValidator{},
+				},
 			},
 			"reviews": schema.ListNestedAttribute{
 				Optional: true,
@@ -232,6 +245,9 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.Int64{
+								int64validator.Between(0, 5),
+							},
 						},
 						"comment": schema.StringAttribute{
 							Optional:            true,
@@ -244,7 +260,7 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 						"date": schema.StringAttribute{
 							Optional:            true,
 							Computed:            true,
-							MarkdownDescription: "Review date of this user",
+							MarkdownDescription: "Review date of this user, in RFC 3339 format",
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
@@ -285,6 +301,9 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 			},
 			"thumbnail": schema.StringAttribute{
 				Optional:            true,
@@ -307,6 +326,37 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// ValidateConfig rejects an availability status that contradicts the stock
+// when both are configured, using the default thresholds of DummyJSON.
+func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var stock types.Int64
+	var status types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("stock"), &stock)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("availability_status"), &status)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Unset, unknown and invalid values are left to the attribute validators
+	if stock.IsNull() || stock.IsUnknown() || stock.ValueInt64() < 0 || status.IsNull() || status.IsUnknown() {
+		return
+	}
+	product := dummyjson.Product{
+		Stock:              uint(stock.ValueInt64()),
+		AvailabilityStatus: dummyjson.AvailabilityStatus(status.ValueString()),
+	}
+	if !product.AvailabilityStatus.IsValid() {
+		return
+	}
+	if err := product.CheckAvailability(dummyjson.DefaultStockThresholds); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("availability_status"),
+			"Inconsistent availability status",
+			fmt.Sprintf("The availability status %q contradicts the stock of %d, expected %q",
+				product.AvailabilityStatus, product.Stock, dummyjson.DefaultStockThresholds.Status(product.Stock)),
+		)
+	}
+}
+
 func (r *ProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -336,92 +386,23 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	configured := dummyjson.Product{
-		Id:                   int(data.Id.ValueInt64()),
-		Title:                data.Title.ValueString(),
-		Description:          data.Description.ValueString(),
-		Category:             data.Category.ValueString(),
-		Price:                data.Price.ValueFloat64(),
-		DiscountPercentage:   data.DiscountPercentage.ValueFloat64(),
-		Rating:               data.Rating.ValueFloat64(),
-		Stock:                uint(data.Stock.ValueInt64()),
-		Brand:                data.Brand.ValueString(),
-		Sku:                  data.Sku.ValueString(),
-		Weight:               data.Weight.ValueFloat64(),
-		WarrantyInfo:         data.WarrantyInfo.ValueString(),
-		ShippingInfo:         data.ShippingInfo.ValueString(),
-		AvailabilityStatus:   data.AvailabilityStatus.ValueString(),
-		ReturnPolicy:         data.ReturnPolicy.ValueString(),
-		MinimumOrderQuantity: uint(data.MinimumOrderQuantity.ValueInt64()),
-		Thumbnail:            data.Thumbnail.ValueString(),
-	}
-
-	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &configured.Tags, true)...)
-	resp.Diagnostics.Append(data.Dimensions.As(ctx, &configured.Dimensions, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
-	resp.Diagnostics.Append(data.Reviews.ElementsAs(ctx, &configured.Reviews, true)...)
-	resp.Diagnostics.Append(data.Images.ElementsAs(ctx, &configured.Images, true)...)
+	configured, diags := data.toProduct(ctx)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	product, err := r.client.UploadProduct(configured)
+	product, err := r.client.UploadProduct(ctx, configured)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating product", fmt.Sprintf("Unable to create a new product, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Error creating product", "Unable to create a new product", err)
 		return
 	}
+	// Update current data with the ones from DummyJSON
+	resp.Diagnostics.Append(data.fromProduct(ctx, product)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var diags diag.Diagnostics
-	// Update current data with the ones from DummyJSON
-	data.Id = types.Int64Value(int64(product.Id))
-	data.Title = types.StringValue(product.Title)
-	data.Description = types.StringValue(product.Description)
-	data.Category = types.StringValue(product.Category)
-	data.Id = types.Int64Value(int64(product.Id))
-	data.Price = types.Float64Value(product.Price)
-	data.DiscountPercentage = types.Float64Value(product.DiscountPercentage)
-	data.Rating = types.Float64Value(product.Rating)
-	data.Stock = types.Int64Value(int64(product.Stock))
-	data.Tags, diags = types.ListValueFrom(ctx, types.StringType, product.Tags)
-	resp.Diagnostics.Append(diags...)
-	data.Brand = types.StringValue(product.Brand)
-	data.Sku = types.StringValue(product.Sku)
-	data.Weight = types.Float64Value(product.Weight)
-
-	dimension := DimensionModel{
-		Width:  types.Float64Value(product.Dimensions.Width),
-		Height: types.Float64Value(product.Dimensions.Height),
-		Depth:  types.Float64Value(product.Dimensions.Depth),
-	}
-	data.Dimensions, diags = types.ObjectValueFrom(ctx, DimensionModelType, dimension)
-	resp.Diagnostics.Append(diags...)
-	data.WarrantyInfo = types.StringValue(product.WarrantyInfo)
-	data.ShippingInfo = types.StringValue(product.ShippingInfo)
-	data.AvailabilityStatus = types.StringValue(product.AvailabilityStatus)
-	data.ReturnPolicy = types.StringValue(product.ReturnPolicy)
-	data.MinimumOrderQuantity = types.Int64Value(int64(product.MinimumOrderQuantity))
-	data.Thumbnail = types.StringValue(product.Thumbnail)
-	data.Images, diags = types.ListValueFrom(ctx, types.StringType, product.Images)
-	resp.Diagnostics.Append(diags...)
-	reviews := make([]types.Object, 0)
-	for _, review := range product.Reviews {
-		rm := ReviewModel{
-			Rating:        types.Int64Value(int64(review.Rating)),
-			Comment:       types.StringValue(review.Comment),
-			Date:          types.StringValue(review.Date.String()),
-			ReviewerName:  types.StringValue(review.ReviewerName),
-			ReviewerEmail: types.StringValue(review.ReviewerEmail),
-		}
-		reviewObj, diags := types.ObjectValueFrom(ctx, ReviewModelType, rm)
-		resp.Diagnostics.Append(diags...)
-		reviews = append(reviews, reviewObj)
-	}
-	data.Reviews, diags = types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: ReviewModelType,
-	}, reviews)
-	resp.Diagnostics.Append(diags...)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -443,61 +424,21 @@ func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	product, err := r.client.GetProduct(int(data.Id.ValueInt64()))
+	product, err := r.client.GetProduct(ctx, int(data.Id.ValueInt64()))
+	if errors.Is(err, dummyjson.ErrNotFound) {
+		// The product was deleted outside of Terraform, let Terraform recreate it
+		tflog.Warn(ctx, "Product not found at DummyJSON, removing it from state", map[string]interface{}{
+			"Product ID": data.Id.ValueInt64(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error getting product", fmt.Sprintf("Unable to read product from DummyJSON, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Error getting product", "Unable to read product from DummyJSON", err)
 		return
 	}
-	var diags diag.Diagnostics
 	// Update current data with the ones from DummyJSON
-	data.Id = types.Int64Value(int64(product.Id))
-	data.Title = types.StringValue(product.Title)
-	data.Description = types.StringValue(product.Description)
-	data.Category = types.StringValue(product.Category)
-	data.Id = types.Int64Value(int64(product.Id))
-	data.Price = types.Float64Value(product.Price)
-	data.DiscountPercentage = types.Float64Value(product.DiscountPercentage)
-	data.Rating = types.Float64Value(product.Rating)
-	data.Stock = types.Int64Value(int64(product.Stock))
-	data.Tags, diags = types.ListValueFrom(ctx, types.StringType, product.Tags)
-	resp.Diagnostics.Append(diags...)
-	data.Brand = types.StringValue(product.Brand)
-	data.Sku = types.StringValue(product.Sku)
-	data.Weight = types.Float64Value(product.Weight)
-
-	dimension := DimensionModel{
-		Width:  types.Float64Value(product.Dimensions.Width),
-		Height: types.Float64Value(product.Dimensions.Height),
-		Depth:  types.Float64Value(product.Dimensions.Depth),
-	}
-	data.Dimensions, diags = types.ObjectValueFrom(ctx, DimensionModelType, dimension)
-	resp.Diagnostics.Append(diags...)
-	data.WarrantyInfo = types.StringValue(product.WarrantyInfo)
-	data.ShippingInfo = types.StringValue(product.ShippingInfo)
-	data.AvailabilityStatus = types.StringValue(product.AvailabilityStatus)
-	data.ReturnPolicy = types.StringValue(product.ReturnPolicy)
-	data.MinimumOrderQuantity = types.Int64Value(int64(product.MinimumOrderQuantity))
-	data.Thumbnail = types.StringValue(product.Thumbnail)
-	data.Images, diags = types.ListValueFrom(ctx, types.StringType, product.Images)
-	resp.Diagnostics.Append(diags...)
-	reviews := make([]types.Object, 0)
-	for _, review := range product.Reviews {
-		rm := ReviewModel{
-			Rating:        types.Int64Value(int64(review.Rating)),
-			Comment:       types.StringValue(review.Comment),
-			Date:          types.StringValue(review.Date.String()),
-			ReviewerName:  types.StringValue(review.ReviewerName),
-			ReviewerEmail: types.StringValue(review.ReviewerEmail),
-		}
-		reviewObj, diags := types.ObjectValueFrom(ctx, ReviewModelType, rm)
-		resp.Diagnostics.Append(diags...)
-		reviews = append(reviews, reviewObj)
-	}
-	data.Reviews, diags = types.ListValueFrom(ctx, types.ObjectType{
-		AttrTypes: ReviewModelType,
-	}, reviews)
-	resp.Diagnostics.Append(diags...)
-
+	resp.Diagnostics.Append(data.fromProduct(ctx, product)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -506,25 +447,53 @@ func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProductResourceModel
+	var plan, state ProductResourceModel
 
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	// Nested attributes that are not configured are unknown in the plan,
+	// keep what we already know about them instead of wiping them out
+	if plan.Dimensions.IsUnknown() {
+		plan.Dimensions = state.Dimensions
+	}
+	if plan.Reviews.IsUnknown() {
+		plan.Reviews = state.Reviews
+	}
+
+	patch, changed, diags := plan.toPatch(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(changed) == 0 {
+		tflog.Trace(ctx, "No product attributes changed, skipping DummyJSON update")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+	tflog.Debug(ctx, "Updating product at DummyJSON", map[string]interface{}{
+		"Product ID": state.Id.ValueInt64(),
+		"Changed":    changed,
+	})
+
+	product, err := r.client.UpdateProduct(ctx, int(state.Id.ValueInt64()), patch)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating product", "Unable to update product at DummyJSON", err)
+		return
+	}
+	// Update current data with the ones from DummyJSON
+	resp.Diagnostics.Append(plan.fromProduct(ctx, product)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -537,15 +506,265 @@ func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete example, got error: %s", err))
-	//     return
-	// }
+	id := int(data.Id.ValueInt64())
+	deleted, err := r.client.DeleteProduct(ctx, id)
+	if errors.Is(err, dummyjson.ErrNotFound) {
+		// Nothing left to delete, the product is already gone
+		tflog.Warn(ctx, "Product not found at DummyJSON, assuming it is already deleted", map[string]interface{}{
+			"Product ID": id,
+		})
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting product", "Unable to delete product from DummyJSON", err)
+		return
+	}
+	if !deleted.IsDeleted {
+		resp.Diagnostics.AddError(
+			"Error deleting product",
+			fmt.Sprintf("DummyJSON did not confirm the deletion of product %d, the product may still exist on the server", id),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted product from DummyJSON", map[string]interface{}{
+		"Product ID": id,
+		"Deleted On": deleted.DeletedOn.String(),
+	})
 }
 
 func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// toProduct converts the Terraform model into a product for DummyJSON.
+func (m ProductResourceModel) toProduct(ctx context.Context) (dummyjson.Product, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	product := dummyjson.Product{
+		Id:                   int(m.Id.ValueInt64()),
+		Title:                m.Title.ValueString(),
+		Description:          m.Description.ValueString(),
+		Category:             m.Category.ValueString(),
+		Price:                m.Price.ValueFloat64(),
+		DiscountPercentage:   m.DiscountPercentage.ValueFloat64(),
+		Rating:               m.Rating.ValueFloat64(),
+		Stock:                uint(int64InRange(&diags, path.Root("stock"), m.Stock, 0, math.MaxInt64)),
+		Brand:                m.Brand.ValueString(),
+		Sku:                  m.Sku.ValueString(),
+		Weight:               m.Weight.ValueFloat64(),
+		WarrantyInfo:         m.WarrantyInfo.ValueString(),
+		ShippingInfo:         m.ShippingInfo.ValueString(),
+		AvailabilityStatus:   dummyjson.AvailabilityStatus(m.AvailabilityStatus.ValueString()),
+		ReturnPolicy:         m.ReturnPolicy.ValueString(),
+		MinimumOrderQuantity: uint(int64InRange(&diags, path.Root("minimum_order_quantity"), m.MinimumOrderQuantity, 0, math.MaxInt64)),
+		Thumbnail:            m.Thumbnail.ValueString(),
+	}
+	diags.Append(m.Tags.ElementsAs(ctx, &product.Tags, true)...)
+	diags.Append(m.Images.ElementsAs(ctx, &product.Images, true)...)
+
+	var d diag.Diagnostics
+	product.Dimensions, d = m.dimensions(ctx)
+	diags.Append(d...)
+	product.Reviews, d = m.reviews(ctx)
+	diags.Append(d...)
+	return product, diags
+}
+
+// fromProduct overwrites the model with the product returned by DummyJSON.
+func (m *ProductResourceModel) fromProduct(ctx context.Context, product dummyjson.Product) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+	m.Id = types.Int64Value(int64(product.Id))
+	m.Title = types.StringValue(product.Title)
+	m.Description = types.StringValue(product.Description)
+	m.Category = types.StringValue(product.Category)
+	m.Price = types.Float64Value(product.Price)
+	m.DiscountPercentage = types.Float64Value(product.DiscountPercentage)
+	m.Rating = types.Float64Value(product.Rating)
+	m.Stock = types.Int64Value(int64(product.Stock))
+	m.Tags, d = types.ListValueFrom(ctx, types.StringType, product.Tags)
+	diags.Append(d...)
+	m.Brand = types.StringValue(product.Brand)
+	m.Sku = types.StringValue(product.Sku)
+	m.Weight = types.Float64Value(product.Weight)
+
+	dimension := DimensionModel{
+		Width:  types.Float64Value(product.Dimensions.Width),
+		Height: types.Float64Value(product.Dimensions.Height),
+		Depth:  types.Float64Value(product.Dimensions.Depth),
+	}
+	m.Dimensions, d = types.ObjectValueFrom(ctx, DimensionModelType, dimension)
+	diags.Append(d...)
+	m.WarrantyInfo = types.StringValue(product.WarrantyInfo)
+	m.ShippingInfo = types.StringValue(product.ShippingInfo)
+	m.AvailabilityStatus = types.StringValue(string(product.AvailabilityStatus))
+	m.ReturnPolicy = types.StringValue(product.ReturnPolicy)
+	m.MinimumOrderQuantity = types.Int64Value(int64(product.MinimumOrderQuantity))
+	m.Thumbnail = types.StringValue(product.Thumbnail)
+	m.Images, d = types.ListValueFrom(ctx, types.StringType, product.Images)
+	diags.Append(d...)
+	reviews := make([]types.Object, 0)
+	for _, review := range product.Reviews {
+		rm := ReviewModel{
+			Rating:        types.Int64Value(int64(review.Rating)),
+			Comment:       types.StringValue(review.Comment),
+			Date:          timestampValue(review.Date),
+			ReviewerName:  types.StringValue(review.ReviewerName),
+			ReviewerEmail: types.StringValue(review.ReviewerEmail),
+		}
+		reviewObj, d := types.ObjectValueFrom(ctx, ReviewModelType, rm)
+		diags.Append(d...)
+		reviews = append(reviews, reviewObj)
+	}
+	m.Reviews, d = types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: ReviewModelType,
+	}, reviews)
+	diags.Append(d...)
+	return diags
+}
+
+// toPatch builds a patch holding the attributes that differ from the prior
+// state, along with the names of these attributes. Attributes left out are
+// not sent so that DummyJSON keeps their current values.
+func (m ProductResourceModel) toPatch(ctx context.Context, prior ProductResourceModel) (dummyjson.ProductPatch, []string, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	var patch dummyjson.ProductPatch
+	var changed []string
+	differs := func(name string, planned, current attr.Value) bool {
+		if planned.Equal(current) {
+			return false
+		}
+		changed = append(changed, name)
+		return true
+	}
+	stringList := func(list types.List) *[]string {
+		var values []string
+		diags.Append(list.ElementsAs(ctx, &values, true)...)
+		return &values
+	}
+
+	if differs("title", m.Title, prior.Title) {
+		patch.Title = dummyjson.Ptr(m.Title.ValueString())
+	}
+	if differs("description", m.Description, prior.Description) {
+		patch.Description = dummyjson.Ptr(m.Description.ValueString())
+	}
+	if differs("category", m.Category, prior.Category) {
+		patch.Category = dummyjson.Ptr(m.Category.ValueString())
+	}
+	if differs("price", m.Price, prior.Price) {
+		patch.Price = dummyjson.Ptr(m.Price.ValueFloat64())
+	}
+	if differs("discount_percentage", m.DiscountPercentage, prior.DiscountPercentage) {
+		patch.DiscountPercentage = dummyjson.Ptr(m.DiscountPercentage.ValueFloat64())
+	}
+	if differs("rating", m.Rating, prior.Rating) {
+		patch.Rating = dummyjson.Ptr(m.Rating.ValueFloat64())
+	}
+	if differs("stock", m.Stock, prior.Stock) {
+		patch.Stock = dummyjson.Ptr(uint(int64InRange(&diags, path.Root("stock"), m.Stock, 0, math.MaxInt64)))
+	}
+	if differs("tags", m.Tags, prior.Tags) {
+		patch.Tags = stringList(m.Tags)
+	}
+	if differs("brand", m.Brand, prior.Brand) {
+		patch.Brand = dummyjson.Ptr(m.Brand.ValueString())
+	}
+	if differs("sku", m.Sku, prior.Sku) {
+		patch.Sku = dummyjson.Ptr(m.Sku.ValueString())
+	}
+	if differs("weight", m.Weight, prior.Weight) {
+		patch.Weight = dummyjson.Ptr(m.Weight.ValueFloat64())
+	}
+	if differs("dimensions", m.Dimensions, prior.Dimensions) {
+		patch.Dimensions, d = m.dimensionPatch(ctx, prior)
+		diags.Append(d...)
+	}
+	if differs("warranty_info", m.WarrantyInfo, prior.WarrantyInfo) {
+		patch.WarrantyInfo = dummyjson.Ptr(m.WarrantyInfo.ValueString())
+	}
+	if differs("shipping_info", m.ShippingInfo, prior.ShippingInfo) {
+		patch.ShippingInfo = dummyjson.Ptr(m.ShippingInfo.ValueString())
+	}
+	if differs("availability_status", m.AvailabilityStatus, prior.AvailabilityStatus) {
+		patch.AvailabilityStatus = dummyjson.Ptr(dummyjson.AvailabilityStatus(m.AvailabilityStatus.ValueString()))
+	}
+	if differs("reviews", m.Reviews, prior.Reviews) {
+		var reviews []dummyjson.Review
+		reviews, d = m.reviews(ctx)
+		diags.Append(d...)
+		patch.Reviews = &reviews
+	}
+	if differs("return_policy", m.ReturnPolicy, prior.ReturnPolicy) {
+		patch.ReturnPolicy = dummyjson.Ptr(m.ReturnPolicy.ValueString())
+	}
+	if differs("minimum_order_quantity", m.MinimumOrderQuantity, prior.MinimumOrderQuantity) {
+		patch.MinimumOrderQuantity = dummyjson.Ptr(uint(int64InRange(&diags, path.Root("minimum_order_quantity"), m.MinimumOrderQuantity, 0, math.MaxInt64)))
+	}
+	if differs("thumbnail", m.Thumbnail, prior.Thumbnail) {
+		patch.Thumbnail = dummyjson.Ptr(m.Thumbnail.ValueString())
+	}
+	if differs("images", m.Images, prior.Images) {
+		patch.Images = stringList(m.Images)
+	}
+	return patch, changed, diags
+}
+
+// dimensions converts the dimensions attribute, unset dimensions are zero.
+func (m ProductResourceModel) dimensions(ctx context.Context) (dummyjson.Dimension, diag.Diagnostics) {
+	var dimension DimensionModel
+	diags := m.Dimensions.As(ctx, &dimension, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	return dummyjson.Dimension{
+		Width:  dimension.Width.ValueFloat64(),
+		Height: dimension.Height.ValueFloat64(),
+		Depth:  dimension.Depth.ValueFloat64(),
+	}, diags
+}
+
+// dimensionPatch holds the dimensions that differ from the prior state, so
+// that DummyJSON keeps the others.
+func (m ProductResourceModel) dimensionPatch(ctx context.Context, prior ProductResourceModel) (*dummyjson.DimensionPatch, diag.Diagnostics) {
+	var planned, current DimensionModel
+	opts := basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true}
+	diags := m.Dimensions.As(ctx, &planned, opts)
+	diags.Append(prior.Dimensions.As(ctx, &current, opts)...)
+	changed := func(planned, current types.Float64) *float64 {
+		if planned.IsUnknown() || planned.Equal(current) {
+			return nil
+		}
+		return dummyjson.Ptr(planned.ValueFloat64())
+	}
+	return &dummyjson.DimensionPatch{
+		Width:  changed(planned.Width, current.Width),
+		Height: changed(planned.Height, current.Height),
+		Depth:  changed(planned.Depth, current.Depth),
+	}, diags
+}
+
+// reviews converts the reviews attribute, reporting the dates that cannot be
+// parsed.
+func (m ProductResourceModel) reviews(ctx context.Context) ([]dummyjson.Review, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []ReviewModel
+	diags.Append(m.Reviews.ElementsAs(ctx, &models, true)...)
+	reviews := make([]dummyjson.Review, 0, len(models))
+	for i, rm := range models {
+		review := dummyjson.Review{
+			Rating:        uint8(int64InRange(&diags, path.Root("reviews").AtListIndex(i).AtName("rating"), rm.Rating, 0, 5)),
+			Comment:       rm.Comment.ValueString(),
+			ReviewerName:  rm.ReviewerName.ValueString(),
+			ReviewerEmail: rm.ReviewerEmail.ValueString(),
+		}
+		date, err := dummyjson.ParseTimestamp(rm.Date.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("reviews").AtListIndex(i).AtName("date"),
+				"Invalid review date",
+				fmt.Sprintf("Unable to parse review date %q, got error: %s", rm.Date.ValueString(), err),
+			)
+			continue
+		}
+		review.Date = date
+		reviews = append(reviews, review)
+	}
+	return reviews, diags
+}
@@ -4,8 +4,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"testing"
 
+	dummyjson "demo.null/dummy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -16,7 +32,7 @@ func TestAccExampleResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: providerConfig + testAccProductResourceConfig,
+				Config: providerConfig + testAccProductResourceConfig("jeff"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dummy_product.test", "title", "jeff"),
 				),
@@ -33,19 +49,389 @@ func TestAccExampleResource(t *testing.T) {
 			// 	ImportStateVerifyIgnore: []string{"configurable_attribute", "defaulted"},
 			// },
 			// Update and Read testing
-			// {
-			// 	Config: testAccExampleResourceConfig("two"),
-			// 	Check: resource.ComposeAggregateTestCheckFunc(
-			// 		resource.TestCheckResourceAttr("scaffolding_example.test", "configurable_attribute", "two"),
-			// 	),
-			// },
+			{
+				Config: providerConfig + testAccProductResourceConfig("jeff two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dummy_product.test", "title", "jeff two"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-var testAccProductResourceConfig = `
+func testAccProductResourceConfig(title string) string {
+	return fmt.Sprintf(`
 resource "dummy_product" "test" {
-  title = "jeff"
+  title = %[1]q
+}
+`, title)
+}
+
+// testProductResourceModel returns a model with the title set and the other
+// attributes null.
+func testProductResourceModel() ProductResourceModel {
+	return ProductResourceModel{
+		Title:      types.StringValue("jeff"),
+		Tags:       types.ListNull(types.StringType),
+		Dimensions: types.ObjectNull(DimensionModelType),
+		Reviews:    types.ListNull(types.ObjectType{AttrTypes: ReviewModelType}),
+		Images:     types.ListNull(types.StringType),
+	}
+}
+
+func testReviews(t *testing.T, ratings ...int64) types.List {
+	elements := make([]attr.Value, len(ratings))
+	for i, rating := range ratings {
+		elements[i] = types.ObjectValueMust(ReviewModelType, map[string]attr.Value{
+			"rating":         types.Int64Value(rating),
+			"comment":        types.StringNull(),
+			"date":           types.StringNull(),
+			"reviewer_name":  types.StringNull(),
+			"reviewer_email": types.StringNull(),
+		})
+	}
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: ReviewModelType}, elements)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return list
+}
+
+func TestProductResourceModelRejectsOutOfRangeIntegers(t *testing.T) {
+	tests := map[string]struct {
+		edit     func(m *ProductResourceModel)
+		wantPath path.Path
+	}{
+		"in range": {
+			edit: func(m *ProductResourceModel) {
+				m.Stock, m.MinimumOrderQuantity, m.Reviews = types.Int64Value(0), types.Int64Value(10), testReviews(t, 0, 5)
+			},
+		},
+		"negative stock": {
+			edit:     func(m *ProductResourceModel) { m.Stock = types.Int64Value(-1) },
+			wantPath: path.Root("stock"),
+		},
+		"negative minimum order quantity": {
+			edit:     func(m *ProductResourceModel) { m.MinimumOrderQuantity = types.Int64Value(-3) },
+			wantPath: path.Root("minimum_order_quantity"),
+		},
+		// 261 used to be truncated to the valid rating 5
+		"review rating over 255": {
+			edit:     func(m *ProductResourceModel) { m.Reviews = testReviews(t, 4, 261) },
+			wantPath: path.Root("reviews").AtListIndex(1).AtName("rating"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			planned := testProductResourceModel()
+			tt.edit(&planned)
+			_, productDiags := planned.toProduct(context.Background())
+			_, _, patchDiags := planned.toPatch(context.Background(), testProductResourceModel())
+			for kind, diags := range map[string]diag.Diagnostics{"product": productDiags, "patch": patchDiags} {
+				if len(tt.wantPath.Steps()) == 0 {
+					if diags.HasError() {
+						t.Errorf("%s: got diagnostics %v, want none", kind, diags)
+					}
+					continue
+				}
+				if diags.ErrorsCount() != 1 {
+					t.Fatalf("%s: got diagnostics %v, want one error", kind, diags)
+				}
+				withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(tt.wantPath) {
+					t.Errorf("%s: got diagnostic %v, want one for %s", kind, diags.Errors()[0], tt.wantPath)
+				}
+			}
+		})
+	}
+}
+
+func TestProductResourceSchemaRejectsOutOfRangeIntegers(t *testing.T) {
+	var resp fwresource.SchemaResponse
+	NewProductResource().Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+	reviews := resp.Schema.Attributes["reviews"].(schema.ListNestedAttribute)
+	tests := map[string]struct {
+		attribute schema.Int64Attribute
+		value     int64
+		wantErr   bool
+	}{
+		"stock":                  {attribute: resp.Schema.Attributes["stock"].(schema.Int64Attribute), value: 0},
+		"negative stock":         {attribute: resp.Schema.Attributes["stock"].(schema.Int64Attribute), value: -1, wantErr: true},
+		"negative minimum order": {attribute: resp.Schema.Attributes["minimum_order_quantity"].(schema.Int64Attribute), value: -1, wantErr: true},
+		"review rating":          {attribute: reviews.NestedObject.Attributes["rating"].(schema.Int64Attribute), value: 5},
+		"review rating over 5":   {attribute: reviews.NestedObject.Attributes["rating"].(schema.Int64Attribute), value: 261, wantErr: true},
+		"negative review rating": {attribute: reviews.NestedObject.Attributes["rating"].(schema.Int64Attribute), value: -1, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.Int64Request{Path: path.Root(name), ConfigValue: types.Int64Value(tt.value)}
+			var diags diag.Diagnostics
+			for _, v := range tt.attribute.Validators {
+				var resp validator.Int64Response
+				v.ValidateInt64(context.Background(), req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProductResourceSchemaRequiresTitle(t *testing.T) {
+	title := testProductResourceSchema().Attributes["title"].(schema.StringAttribute)
+	if !title.IsRequired() || title.IsComputed() {
+		t.Errorf("got title required: %t, computed: %t, want a required attribute", title.IsRequired(), title.IsComputed())
+	}
+	tests := map[string]struct {
+		value   types.String
+		wantErr bool
+	}{
+		"title":   {value: types.StringValue("jeff")},
+		"unknown": {value: types.StringUnknown()},
+		"empty":   {value: types.StringValue(""), wantErr: true},
+		"blank":   {value: types.StringValue(" \t"), wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("title"), ConfigValue: tt.value}
+			var diags diag.Diagnostics
+			for _, v := range title.Validators {
+				var resp validator.StringResponse
+				v.ValidateString(context.Background(), req, &resp)
+				diags.Append(resp.Diagnostics...)
+			}
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+// testProductResourceSchema returns the schema of the product resource.
+func testProductResourceSchema() schema.Schema {
+	var resp fwresource.SchemaResponse
+	NewProductResource().Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// testProductResource returns a product resource whose client talks to a
+// server running handler, without retrying.
+func testProductResource(t *testing.T, handler http.HandlerFunc) *ProductResource {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &ProductResource{client: dummyjson.NewDummyClient(srv.URL, dummyjson.WithRetry(dummyjson.RetryPolicy{}))}
+}
+
+// testProductState returns the state holding m.
+func testProductState(t *testing.T, m ProductResourceModel) tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{Schema: testProductResourceSchema()}
+	if diags := state.Set(context.Background(), &m); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return state
+}
+
+// testResponse answers with status and the JSON document body.
+func testResponse(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+func TestProductResourceDelete(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr bool
+	}{
+		"deleted":                {status: http.StatusOK, body: `{"id":1,"isDeleted":true,"deletedOn":"2024-05-23T08:56:21.618Z"}`},
+		"already gone":           {status: http.StatusNotFound, body: `{"message":"Product with id '1' not found"}`},
+		"deletion not confirmed": {status: http.StatusOK, body: `{"id":1,"isDeleted":false}`, wantErr: true},
+		"server error":           {status: http.StatusInternalServerError, body: `{"message":"boom"}`, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var method, urlPath string
+			r := testProductResource(t, func(w http.ResponseWriter, req *http.Request) {
+				method, urlPath = req.Method, req.URL.Path
+				testResponse(tt.status, tt.body)(w, req)
+			})
+			m := testProductResourceModel()
+			m.Id = types.Int64Value(1)
+			req := fwresource.DeleteRequest{State: testProductState(t, m)}
+			resp := fwresource.DeleteResponse{State: req.State}
+			r.Delete(context.Background(), req, &resp)
+			if method != http.MethodDelete || urlPath != "/products/1" {
+				t.Errorf("got request %s %s, want DELETE /products/1", method, urlPath)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProductResourceRead(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		body        string
+		wantRemoved bool
+		wantTitle   string
+		wantErr     bool
+	}{
+		"found":        {status: http.StatusOK, body: `{"id":1,"title":"jeff two"}`, wantTitle: "jeff two"},
+		"gone":         {status: http.StatusNotFound, body: `{"message":"Product with id '1' not found"}`, wantRemoved: true},
+		"server error": {status: http.StatusInternalServerError, body: `{"message":"boom"}`, wantTitle: "jeff", wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var urlPath string
+			r := testProductResource(t, func(w http.ResponseWriter, req *http.Request) {
+				urlPath = req.URL.Path
+				testResponse(tt.status, tt.body)(w, req)
+			})
+			m := testProductResourceModel()
+			m.Id = types.Int64Value(1)
+			req := fwresource.ReadRequest{State: testProductState(t, m)}
+			resp := fwresource.ReadResponse{State: req.State}
+			r.Read(context.Background(), req, &resp)
+			if urlPath != "/products/1" {
+				t.Errorf("got request to %s, want /products/1", urlPath)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got diagnostics %v, want error: %t", resp.Diagnostics, tt.wantErr)
+			}
+			if removed := resp.State.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Fatalf("state removed: %t, want %t", removed, tt.wantRemoved)
+			}
+			if tt.wantRemoved {
+				return
+			}
+			var title types.String
+			resp.State.GetAttribute(context.Background(), path.Root("title"), &title)
+			if title.ValueString() != tt.wantTitle {
+				t.Errorf("got title %q in state, want %q", title.ValueString(), tt.wantTitle)
+			}
+		})
+	}
+}
+
+func testDimensions(width, height, depth float64) types.Object {
+	return types.ObjectValueMust(DimensionModelType, map[string]attr.Value{
+		"width":  types.Float64Value(width),
+		"height": types.Float64Value(height),
+		"depth":  types.Float64Value(depth),
+	})
+}
+
+// testProductPriorModel returns the model of a product as read from
+// DummyJSON, with its stock, tags and dimensions set.
+func testProductPriorModel() ProductResourceModel {
+	m := testProductResourceModel()
+	m.Id = types.Int64Value(1)
+	m.Stock = types.Int64Value(5)
+	m.Tags = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("beauty")})
+	m.Dimensions = testDimensions(1, 2, 3)
+	return m
+}
+
+func TestProductResourceToPatch(t *testing.T) {
+	tests := map[string]struct {
+		edit        func(m *ProductResourceModel)
+		wantChanged []string
+		wantPatch   string
+	}{
+		"unchanged": {
+			edit:      func(m *ProductResourceModel) {},
+			wantPatch: `{}`,
+		},
+		"stock": {
+			edit:        func(m *ProductResourceModel) { m.Stock = types.Int64Value(3) },
+			wantChanged: []string{"stock"},
+			wantPatch:   `{"stock":3}`,
+		},
+		"title and stock": {
+			edit: func(m *ProductResourceModel) {
+				m.Title, m.Stock = types.StringValue("jeff two"), types.Int64Value(0)
+			},
+			wantChanged: []string{"title", "stock"},
+			wantPatch:   `{"title":"jeff two","stock":0}`,
+		},
+		"cleared tags": {
+			edit:        func(m *ProductResourceModel) { m.Tags = types.ListValueMust(types.StringType, nil) },
+			wantChanged: []string{"tags"},
+			wantPatch:   `{"tags":[]}`,
+		},
+		"one dimension": {
+			edit:        func(m *ProductResourceModel) { m.Dimensions = testDimensions(4, 2, 3) },
+			wantChanged: []string{"dimensions"},
+			wantPatch:   `{"dimensions":{"width":4}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			planned := testProductPriorModel()
+			tt.edit(&planned)
+			patch, changed, diags := planned.toPatch(context.Background(), testProductPriorModel())
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !slices.Equal(changed, tt.wantChanged) {
+				t.Errorf("got changed attributes %v, want %v", changed, tt.wantChanged)
+			}
+			body, err := json.Marshal(patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tt.wantPatch {
+				t.Errorf("got patch %s, want %s", body, tt.wantPatch)
+			}
+		})
+	}
+}
+
+func TestProductResourceUpdate(t *testing.T) {
+	tests := map[string]struct {
+		stock        int64
+		wantRequests []string
+	}{
+		"unchanged": {stock: 5},
+		"stock":     {stock: 3, wantRequests: []string{`PATCH /products/1 {"stock":3}`}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			r := testProductResource(t, func(w http.ResponseWriter, req *http.Request) {
+				body, _ := io.ReadAll(req.Body)
+				requests = append(requests, fmt.Sprintf("%s %s %s", req.Method, req.URL.Path, body))
+				testResponse(http.StatusOK, fmt.Sprintf(`{"id":1,"title":"jeff","stock":%d,"tags":["beauty"],"dimensions":{"width":1,"height":2,"depth":3}}`, tt.stock))(w, req)
+			})
+			planned := testProductPriorModel()
+			planned.Stock = types.Int64Value(tt.stock)
+			plan := tfsdk.Plan{Schema: testProductResourceSchema()}
+			if diags := plan.Set(context.Background(), &planned); diags.HasError() {
+				t.Fatal(diags)
+			}
+			req := fwresource.UpdateRequest{Plan: plan, State: testProductState(t, testProductPriorModel())}
+			resp := fwresource.UpdateResponse{State: req.State}
+			r.Update(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			if !slices.Equal(requests, tt.wantRequests) {
+				t.Errorf("got requests %q, want %q", requests, tt.wantRequests)
+			}
+			var stock types.Int64
+			resp.State.GetAttribute(context.Background(), path.Root("stock"), &stock)
+			if stock.ValueInt64() != tt.stock {
+				t.Errorf("got stock %d in state, want %d", stock.ValueInt64(), tt.stock)
+			}
+		})
+	}
 }
-`
@@ -8,9 +8,11 @@ import (
 	"fmt"
 
 	dummyjson "demo.null/dummy"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,9 +29,14 @@ type ProductsDataSource struct {
 	client *dummyjson.DummyClient
 }
 type ProductsDataSourceModel struct {
-	Products types.List `tfsdk:"products"`
+	Concurrency types.Int64 `tfsdk:"concurrency"`
+	Products    types.List  `tfsdk:"products"`
 }
 
+// defaultProductsConcurrency is the number of pages fetched in parallel when
+// the concurrency attribute is not set.
+const defaultProductsConcurrency = 4
+
 func (d *ProductsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_products"
 }
@@ -40,6 +47,13 @@ func (d *ProductsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "Products data source",
 
 		Attributes: map[string]schema.Attribute{
+			"concurrency": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Number of pages of products fetched in parallel, `1` fetches them one by one. Defaults to `%d`", defaultProductsConcurrency),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"products": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of all products in DummyJSON",
@@ -138,7 +152,7 @@ func (d *ProductsDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 									},
 									"date": schema.StringAttribute{
 										Computed:            true,
-										MarkdownDescription: "Review date of this user",
+										MarkdownDescription: "Review date of this user, in RFC 3339 format",
 									},
 									"reviewer_name": schema.StringAttribute{
 										Computed:            true,
@@ -210,9 +224,21 @@ func (d *ProductsDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	products, err := d.client.GetProducts()
+	concurrency := int64(defaultProductsConcurrency)
+	if !data.Concurrency.IsNull() {
+		concurrency = data.Concurrency.ValueInt64()
+	}
+	products, err := d.client.GetProducts(ctx, &dummyjson.ListOptions{
+		Concurrency: int(concurrency),
+		Progress: func(fetched, total int) {
+			tflog.Debug(ctx, "Fetching products from DummyJSON", map[string]interface{}{
+				"Fetched": fetched,
+				"Total":   total,
+			})
+		},
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("DummyClient Error", fmt.Sprintf("Unable to get products from DummyJSON , got error: %s", err))
+		addClientError(&resp.Diagnostics, "DummyClient Error", "Unable to get products from DummyJSON", err)
 		return
 	}
 	// Product data with terraform types
@@ -232,7 +258,7 @@ func (d *ProductsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			Weight:               types.Float64Value(p.Weight),
 			WarrantyInfo:         types.StringValue(p.WarrantyInfo),
 			ShippingInfo:         types.StringValue(p.ShippingInfo),
-			AvailabilityStatus:   types.StringValue(p.AvailabilityStatus),
+			AvailabilityStatus:   types.StringValue(string(p.AvailabilityStatus)),
 			ReturnPolicy:         types.StringValue(p.ReturnPolicy),
 			MinimumOrderQuantity: types.Int64Value(int64(p.MinimumOrderQuantity)),
 			Thumbnail:            types.StringValue(p.ShippingInfo),
@@ -246,7 +272,7 @@ func (d *ProductsDataSource) Read(ctx context.Context, req datasource.ReadReques
 			rm := ReviewModel{
 				Rating:        types.Int64Value(int64(review.Rating)),
 				Comment:       types.StringValue(review.Comment),
-				Date:          types.StringValue(review.Date.String()),
+				Date:          timestampValue(review.Date),
 				ReviewerName:  types.StringValue(review.ReviewerName),
 				ReviewerEmail: types.StringValue(review.ReviewerEmail),
 			}
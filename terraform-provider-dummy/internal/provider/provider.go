@@ -5,14 +5,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	dummyjson "demo.null/dummy"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
@@ -30,7 +36,21 @@ type DummyProvider struct {
 
 // ScaffoldingProviderModel describes the provider data model.
 type DummyProviderModel struct {
-	Url types.String `tfsdk:"url"`
+	Url         types.String `tfsdk:"url"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	AccessToken types.String `tfsdk:"access_token"`
+	Retry       types.Object `tfsdk:"retry"`
+}
+
+// RetryModel describes the retry settings of the provider.
+type RetryModel struct {
+	MaxAttempts   types.Int64  `tfsdk:"max_attempts"`
+	BaseBackoff   types.String `tfsdk:"base_backoff"`
+	MaxBackoff    types.String `tfsdk:"max_backoff"`
+	MaxRetryAfter types.String `tfsdk:"max_retry_after"`
+	Jitter        types.Bool   `tfsdk:"jitter"`
+	RetryPost     types.Bool   `tfsdk:"retry_post"`
 }
 
 func (p *DummyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,6 +65,50 @@ func (p *DummyProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "URL of the DummyJSON",
 				Required:            true,
 			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username to log in to DummyJSON with, requires `password`",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password to log in to DummyJSON with, requires `username`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token sent to DummyJSON, conflicts with `username` and `password`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"retry": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Retry settings for requests failing with a network error, HTTP 429 or HTTP 5xx",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Total number of attempts per request, `1` disables retries. Defaults to `3`",
+					},
+					"base_backoff": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Wait before the first retry, doubled on every attempt. Defaults to `500ms`",
+					},
+					"max_backoff": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum wait between attempts, unless the server asks for more with `Retry-After`. Defaults to `10s`",
+					},
+					"max_retry_after": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Longest `Retry-After` honoured, requests asked to wait longer fail instead. Defaults to `1m`",
+					},
+					"jitter": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Randomise the wait between attempts. Defaults to `true`",
+					},
+					"retry_post": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Also retry product creation, which may create duplicate products. Defaults to `false`",
+					},
+				},
+			},
 		},
 	}
 }
@@ -69,12 +133,107 @@ func (p *DummyProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	retry := dummyjson.DefaultRetryPolicy
+	if !data.Retry.IsNull() && !data.Retry.IsUnknown() {
+		var retryData RetryModel
+		resp.Diagnostics.Append(data.Retry.As(ctx, &retryData, basetypes.ObjectAsOptions{})...)
+		resp.Diagnostics.Append(retryData.apply(&retry)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Credentials computed by other resources are unknown until these are
+	// applied, their empty value would break the login or silently skip
+	// authentication
+	for _, credential := range []struct {
+		name  string
+		value types.String
+	}{
+		{"username", data.Username},
+		{"password", data.Password},
+		{"access token", data.AccessToken},
+	} {
+		if credential.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(strings.ReplaceAll(credential.name, " ", "_")),
+				fmt.Sprintf("Unknown DummyJSON %s", credential.name),
+				fmt.Sprintf("The provider cannot create the DummyJSON client as there is an unknown configuration value for the %s. "+
+					"Either apply the source of the value first or set the value statically in the configuration.", credential.name),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Username.IsNull() != data.Password.IsNull() {
+		resp.Diagnostics.AddError("Incomplete credentials provided!", "Please provide both username and password to log in to DummyJSON")
+		return
+	}
+	if !data.AccessToken.IsNull() && !data.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("access_token"),
+			"Conflicting credentials provided!",
+			"Please provide either an access token or a username and password to authenticate to DummyJSON, not both",
+		)
+		return
+	}
+
 	// Example client configuration for data sources and resources
-	client := dummyjson.NewDummyClient(data.Url.ValueString())
+	opts := []dummyjson.Option{
+		dummyjson.WithUserAgent(fmt.Sprintf("terraform-provider-dummy/%s terraform/%s", p.version, req.TerraformVersion)),
+		dummyjson.WithRetry(retry),
+	}
+	if !data.AccessToken.IsNull() {
+		opts = append(opts, dummyjson.WithAccessToken(data.AccessToken.ValueString()))
+	}
+	client := dummyjson.NewDummyClient(data.Url.ValueString(), opts...)
+	if !data.Username.IsNull() {
+		// Log in right away so that wrong credentials are reported here
+		_, err := client.Login(ctx, data.Username.ValueString(), data.Password.ValueString())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to log in to DummyJSON", "Unable to log in with the configured username and password", err)
+			return
+		}
+	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// apply overrides the retry policy with the configured settings.
+func (m RetryModel) apply(policy *dummyjson.RetryPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !m.MaxAttempts.IsNull() {
+		policy.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+	parseBackoff := func(name string, value types.String, target *time.Duration) {
+		if value.IsNull() {
+			return
+		}
+		backoff, err := time.ParseDuration(value.ValueString())
+		if err != nil || backoff < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName(name),
+				"Invalid retry backoff",
+				fmt.Sprintf("Expected a positive duration such as \"500ms\" or \"2s\", got: %q", value.ValueString()),
+			)
+			return
+		}
+		*target = backoff
+	}
+	parseBackoff("base_backoff", m.BaseBackoff, &policy.BaseBackoff)
+	parseBackoff("max_backoff", m.MaxBackoff, &policy.MaxBackoff)
+	parseBackoff("max_retry_after", m.MaxRetryAfter, &policy.MaxRetryAfter)
+	if !m.Jitter.IsNull() {
+		policy.Jitter = m.Jitter.ValueBool()
+	}
+	if !m.RetryPost.IsNull() {
+		policy.RetryPost = m.RetryPost.ValueBool()
+	}
+	return diags
+}
+
 func (p *DummyProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewProductResource,
@@ -4,10 +4,19 @@
 package provider
 
 import (
-	// "testing"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -29,3 +38,102 @@ provider "dummy" {
 // about the appropriate environment variables being set are common to see in a pre-check
 // function.
 // }
+
+// testProviderConfig returns a configuration of the provider where the
+// attributes of values are set and the others are null.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	var resp provider.SchemaResponse
+	New("test")().Schema(context.Background(), provider.SchemaRequest{}, &resp)
+	objectType := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("unknown attribute %s", name)
+		}
+		attributes[name] = value
+	}
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestProviderConfigureCredentials(t *testing.T) {
+	known := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	tests := map[string]struct {
+		values     map[string]tftypes.Value
+		wantLogins int32
+		wantErr    bool
+		// wantPath is the attribute of the error, none for the provider
+		wantPath path.Path
+	}{
+		"anonymous":    {},
+		"credentials":  {values: map[string]tftypes.Value{"username": known("emilys"), "password": known("emilyspass")}, wantLogins: 1},
+		"access token": {values: map[string]tftypes.Value{"access_token": known("token")}},
+		"unknown username": {
+			values:   map[string]tftypes.Value{"username": unknown, "password": known("emilyspass")},
+			wantPath: path.Root("username"),
+			wantErr:  true,
+		},
+		"unknown password": {
+			values:   map[string]tftypes.Value{"username": known("emilys"), "password": unknown},
+			wantPath: path.Root("password"),
+			wantErr:  true,
+		},
+		"unknown access token": {
+			values:   map[string]tftypes.Value{"access_token": unknown},
+			wantPath: path.Root("access_token"),
+			wantErr:  true,
+		},
+		"incomplete credentials": {
+			values:  map[string]tftypes.Value{"username": known("emilys")},
+			wantErr: true,
+		},
+		"conflicting credentials": {
+			values:   map[string]tftypes.Value{"username": known("emilys"), "password": known("emilyspass"), "access_token": known("token")},
+			wantPath: path.Root("access_token"),
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var logins atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/auth/login" {
+					logins.Add(1)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id":1,"username":"emilys","accessToken":"a","refreshToken":"r"}`))
+			}))
+			t.Cleanup(srv.Close)
+			values := map[string]tftypes.Value{"url": known(srv.URL)}
+			for name, value := range tt.values {
+				values[name] = value
+			}
+			req := provider.ConfigureRequest{Config: testProviderConfig(t, values)}
+			var resp provider.ConfigureResponse
+			New("test")().Configure(context.Background(), req, &resp)
+			if got := logins.Load(); got != tt.wantLogins {
+				t.Errorf("got %d logins, want %d", got, tt.wantLogins)
+			}
+			if !tt.wantErr {
+				if resp.Diagnostics.HasError() || resp.ResourceData == nil {
+					t.Errorf("got diagnostics %v and client %v, want a client", resp.Diagnostics, resp.ResourceData)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.ResourceData != nil {
+				t.Fatalf("got diagnostics %v and client %v, want one error", resp.Diagnostics, resp.ResourceData)
+			}
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			if len(tt.wantPath.Steps()) == 0 {
+				if ok {
+					t.Errorf("got diagnostic for %s, want one for the provider", withPath.Path())
+				}
+			} else if !ok || !withPath.Path().Equal(tt.wantPath) {
+				t.Errorf("got diagnostic %v, want one for %s", resp.Diagnostics.Errors()[0], tt.wantPath)
+			}
+		})
+	}
+}